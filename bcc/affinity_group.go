@@ -53,7 +53,10 @@ func (m *Manager) GetAffinityGroup(id string) (affinityGroup *AffinityGroup, err
 	if err = m.Get(path, Defaults(), &affinityGroup); err != nil {
 		log.Printf("[REQUEST-ERROR] get-affinityGroup failed: %s", err)
 	} else {
-		affinityGroup.Vdc.manager = m
+		affinityGroup.manager = m
+		if affinityGroup.Vdc != nil {
+			affinityGroup.Vdc.manager = m
+		}
 	}
 
 	return
@@ -105,7 +108,7 @@ func (a *AffinityGroup) Update() (err error) {
 		Name        string   `json:"name"`
 		Description string   `json:"description"`
 		Policy      string   `json:"policy"`
-		Vms         []string `json:"vms,omitempty"`
+		Vms         []string `json:"vms"`
 	}{
 		Name:        a.Name,
 		Description: a.Description,
@@ -120,6 +123,28 @@ func (a *AffinityGroup) Update() (err error) {
 	return
 }
 
+func (a *AffinityGroup) AddVm(vm *Vm) error {
+	for _, groupVm := range a.Vms {
+		if groupVm.ID == vm.ID {
+			return nil
+		}
+	}
+
+	a.Vms = append(a.Vms, &MetaData{ID: vm.ID, Name: vm.Name})
+	return a.Update()
+}
+
+func (a *AffinityGroup) RemoveVm(vm *Vm) error {
+	for i, groupVm := range a.Vms {
+		if groupVm.ID == vm.ID {
+			a.Vms = append(a.Vms[:i], a.Vms[i+1:]...)
+			return a.Update()
+		}
+	}
+
+	return nil
+}
+
 func (a *AffinityGroup) Delete() (err error) {
 	path, _ := url.JoinPath("v1/affinity_group", a.ID)
 	if err = a.manager.Delete(path, Defaults(), nil); err != nil {
@@ -0,0 +1,85 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type ApiToken struct {
+	manager   *Manager
+	accountId string
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	// Token is only returned when the token is created, store it right away.
+	Token     string `json:"token,omitempty"`
+	ExpiresAt *Time  `json:"expires_at,omitempty"`
+	CreatedAt Time   `json:"created_at"`
+	LastUsed  *Time  `json:"last_used,omitempty"`
+}
+
+// NewApiToken describes a token limited to the scopes, an empty list grants
+// the full access of the account. A zero expiresAt never expires.
+func NewApiToken(name string, scopes []string, expiresAt time.Time) ApiToken {
+	t := ApiToken{Name: name, Scopes: scopes}
+	if !expiresAt.IsZero() {
+		t.ExpiresAt = &Time{expiresAt}
+	}
+	return t
+}
+
+func (a *Account) GetApiTokens(extraArgs ...Arguments) (tokens []*ApiToken, err error) {
+	path := fmt.Sprintf("/v1/account/%s/token", a.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = a.manager.GetItems(path, args, &tokens); err != nil {
+		log.Printf("[REQUEST-ERROR] get-apiToken list failed: %s", err)
+	} else {
+		for i := range tokens {
+			tokens[i].manager = a.manager
+			tokens[i].accountId = a.ID
+		}
+	}
+
+	return
+}
+
+func (a *Account) CreateApiToken(token *ApiToken) (err error) {
+	path := fmt.Sprintf("/v1/account/%s/token", a.ID)
+	args := &struct {
+		Name      string   `json:"name"`
+		Scopes    []string `json:"scopes"`
+		ExpiresAt *string  `json:"expires_at,omitempty"`
+	}{
+		Name:   token.Name,
+		Scopes: token.Scopes,
+	}
+
+	if args.Scopes == nil {
+		args.Scopes = []string{}
+	}
+	if token.ExpiresAt != nil {
+		expiresAt := token.ExpiresAt.UTC().Format(time.RFC3339)
+		args.ExpiresAt = &expiresAt
+	}
+
+	if err = a.manager.Request("POST", path, args, &token); err != nil {
+		log.Printf("[REQUEST-ERROR] create-apiToken failed: %s", err)
+	} else {
+		token.manager = a.manager
+		token.accountId = a.ID
+	}
+
+	return
+}
+
+// Revoke invalidates the token, requests made with it fail afterwards.
+func (t *ApiToken) Revoke() (err error) {
+	path := fmt.Sprintf("/v1/account/%s/token/%s", t.accountId, t.ID)
+	if err = t.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] revoke-apiToken failed: %s", err)
+	}
+	return
+}
@@ -0,0 +1,82 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type AuditLogEntry struct {
+	ID         string    `json:"id"`
+	Action     string    `json:"action"`
+	ObjectType string    `json:"object_type"`
+	ObjectID   string    `json:"object_id"`
+	ObjectName string    `json:"object_name"`
+	User       *MetaData `json:"user"`
+	Project    *MetaData `json:"project,omitempty"`
+	IpAddress  string    `json:"ip_address"`
+	Success    bool      `json:"success"`
+	CreatedAt  Time      `json:"created_at"`
+}
+
+// AuditLogFilter narrows the audit log down, zero fields are not filtered
+// on. Limit caps the amount of entries returned, no more pages are fetched
+// once it is reached. Without it the whole log is fetched.
+type AuditLogFilter struct {
+	From       time.Time
+	To         time.Time
+	User       string
+	ObjectType string
+	ObjectID   string
+	Action     string
+	Limit      int
+}
+
+func (f AuditLogFilter) args() Arguments {
+	args := Defaults()
+	if !f.From.IsZero() {
+		args["from"] = f.From.UTC().Format(time.RFC3339)
+	}
+	if !f.To.IsZero() {
+		args["to"] = f.To.UTC().Format(time.RFC3339)
+	}
+	if f.User != "" {
+		args["user"] = f.User
+	}
+	if f.ObjectType != "" {
+		args["object_type"] = f.ObjectType
+	}
+	if f.ObjectID != "" {
+		args["object_id"] = f.ObjectID
+	}
+	if f.Action != "" {
+		args["action"] = f.Action
+	}
+	return args
+}
+
+func (m *Manager) GetAuditLog(filter AuditLogFilter, extraArgs ...Arguments) (entries []*AuditLogEntry, err error) {
+	path := "v1/audit_log"
+	args := filter.args()
+	args.merge(extraArgs)
+
+	if filter.Limit > 0 {
+		// ask for a page of Limit entries, so a single request is enough
+		args["limit"] = fmt.Sprint(filter.Limit)
+	}
+
+	if err = m.getItems(path, args, &entries, filter.Limit); err != nil {
+		log.Printf("[REQUEST-ERROR] get-audit-log failed: %s", err)
+	}
+
+	return
+}
+
+func (p *Project) GetAuditLog(filter AuditLogFilter, extraArgs ...Arguments) (entries []*AuditLogEntry, err error) {
+	args := Arguments{
+		"project": p.ID,
+	}
+	args.merge(extraArgs)
+	entries, err = p.manager.GetAuditLog(filter, args)
+	return
+}
@@ -0,0 +1,166 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+)
+
+const (
+	BackupJobStatusActive  = "active"
+	BackupJobStatusPaused  = "paused"
+	BackupJobStatusRunning = "running"
+	BackupJobStatusFailed  = "failed"
+)
+
+type BackupJob struct {
+	manager   *Manager
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Vdc       *MetaData        `json:"vdc"`
+	Vms       []*MetaData      `json:"vms"`
+	Disks     []*MetaData      `json:"disks"`
+	Cron      string           `json:"cron"`
+	Timezone  string           `json:"timezone,omitempty"`
+	Retention *BackupRetention `json:"retention,omitempty"`
+	Status    string           `json:"status"`
+	LastRun   *Time            `json:"last_run,omitempty"`
+	NextRun   *Time            `json:"next_run,omitempty"`
+	Locked    bool             `json:"locked"`
+}
+
+// NewBackupJob describes a backup of the vms and disks which runs whenever
+// the cron expression matches, e.g. "0 2 * * *" for every night.
+func NewBackupJob(name string, vms []*Vm, disks []*Disk, cron string, timezone string) BackupJob {
+	j := BackupJob{Name: name, Cron: cron, Timezone: timezone}
+	for _, vm := range vms {
+		j.Vms = append(j.Vms, &MetaData{ID: vm.ID, Name: vm.Name})
+	}
+	for _, disk := range disks {
+		j.Disks = append(j.Disks, &MetaData{ID: disk.ID, Name: disk.Name})
+	}
+	return j
+}
+
+func (m *Manager) GetBackupJobs(extraArgs ...Arguments) (jobs []*BackupJob, err error) {
+	path := "v1/backup/job"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &jobs); err != nil {
+		log.Printf("[REQUEST-ERROR] get-backup-job list failed: %s", err)
+	} else {
+		for i := range jobs {
+			jobs[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (v *Vdc) GetBackupJobs(extraArgs ...Arguments) (jobs []*BackupJob, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	jobs, err = v.manager.GetBackupJobs(args)
+	return
+}
+
+func (m *Manager) GetBackupJob(id string) (job *BackupJob, err error) {
+	path, _ := url.JoinPath("v1/backup/job", id)
+
+	if err = m.Get(path, Defaults(), &job); err != nil {
+		log.Printf("[REQUEST-ERROR] get-backup-job failed: %s", err)
+	} else {
+		job.manager = m
+	}
+
+	return
+}
+
+func (v *Vdc) CreateBackupJob(job *BackupJob) (err error) {
+	path := "v1/backup/job"
+	args := &struct {
+		Name      string           `json:"name"`
+		Vdc       string           `json:"vdc"`
+		Vms       []string         `json:"vms"`
+		Disks     []string         `json:"disks"`
+		Cron      string           `json:"cron"`
+		Timezone  string           `json:"timezone,omitempty"`
+		Retention *BackupRetention `json:"retention,omitempty"`
+	}{
+		Name:      job.Name,
+		Vdc:       v.ID,
+		Vms:       convertNameToId(job.Vms),
+		Disks:     convertNameToId(job.Disks),
+		Cron:      job.Cron,
+		Timezone:  job.Timezone,
+		Retention: job.Retention,
+	}
+
+	if err = v.manager.Request("POST", path, args, &job); err != nil {
+		log.Printf("[REQUEST-ERROR] create-backup-job failed: %s", err)
+	} else {
+		job.manager = v.manager
+	}
+
+	return
+}
+
+func (j *BackupJob) Update() (err error) {
+	path, _ := url.JoinPath("v1/backup/job", j.ID)
+	args := &struct {
+		Name      string           `json:"name"`
+		Vms       []string         `json:"vms"`
+		Disks     []string         `json:"disks"`
+		Cron      string           `json:"cron"`
+		Timezone  string           `json:"timezone,omitempty"`
+		Retention *BackupRetention `json:"retention,omitempty"`
+	}{
+		Name:      j.Name,
+		Vms:       convertNameToId(j.Vms),
+		Disks:     convertNameToId(j.Disks),
+		Cron:      j.Cron,
+		Timezone:  j.Timezone,
+		Retention: j.Retention,
+	}
+
+	if err = j.manager.Request("PUT", path, args, j); err != nil {
+		log.Printf("[REQUEST-ERROR] update-backup-job failed: %s", err)
+	}
+
+	return
+}
+
+// Pause stops scheduled runs of the job, existing restore points are kept.
+func (j *BackupJob) Pause() error {
+	return j.setState("pause")
+}
+
+func (j *BackupJob) Resume() error {
+	return j.setState("resume")
+}
+
+func (j *BackupJob) setState(action string) (err error) {
+	path := fmt.Sprintf("v1/backup/job/%s/%s", j.ID, action)
+
+	if err = j.manager.Request("POST", path, nil, j); err != nil {
+		log.Printf("[REQUEST-ERROR] %s-backup-job failed: %s", action, err)
+	}
+
+	return
+}
+
+func (j *BackupJob) Delete() (err error) {
+	path, _ := url.JoinPath("v1/backup/job", j.ID)
+	if err = j.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-backup-job failed: %s", err)
+	}
+	return
+}
+
+func (j BackupJob) WaitLock() (err error) {
+	path, _ := url.JoinPath("v1/backup/job", j.ID)
+	return loopWaitLock(j.manager, path)
+}
@@ -0,0 +1,117 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+const (
+	RestoreModeInPlace = "in_place"
+	RestoreModeNewVm   = "new_vm"
+	RestoreModeNewDisk = "new_disk"
+)
+
+type BackupRestorePoint struct {
+	manager   *Manager
+	ID        string    `json:"id"`
+	Job       *MetaData `json:"job"`
+	Vm        *MetaData `json:"vm,omitempty"`
+	Disk      *MetaData `json:"disk,omitempty"`
+	Size      int64     `json:"size"`
+	Status    string    `json:"status"`
+	CreatedAt Time      `json:"created_at"`
+}
+
+func (m *Manager) GetBackupRestorePoints(extraArgs ...Arguments) (points []*BackupRestorePoint, err error) {
+	path := "v1/backup/restore_point"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &points); err != nil {
+		log.Printf("[REQUEST-ERROR] get-backup-restore-point list failed: %s", err)
+	} else {
+		for i := range points {
+			points[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (j *BackupJob) GetRestorePoints(extraArgs ...Arguments) (points []*BackupRestorePoint, err error) {
+	args := Arguments{
+		"job": j.ID,
+	}
+	args.merge(extraArgs)
+	points, err = j.manager.GetBackupRestorePoints(args)
+	return
+}
+
+func (v *Vm) GetBackupRestorePoints(extraArgs ...Arguments) (points []*BackupRestorePoint, err error) {
+	args := Arguments{
+		"vm": v.ID,
+	}
+	args.merge(extraArgs)
+	points, err = v.manager.GetBackupRestorePoints(args)
+	return
+}
+
+func (d *Disk) GetBackupRestorePoints(extraArgs ...Arguments) (points []*BackupRestorePoint, err error) {
+	args := Arguments{
+		"disk": d.ID,
+	}
+	args.merge(extraArgs)
+	points, err = d.manager.GetBackupRestorePoints(args)
+	return
+}
+
+// RestoreInPlace rolls the backed up vm or disk back to the restore point.
+// Changes made since the restore point are lost.
+func (p *BackupRestorePoint) RestoreInPlace() (*TaskHandle, error) {
+	return p.restore(RestoreModeInPlace, "", nil)
+}
+
+// RestoreToNewVm creates a vm with the given name in vdc from the restore
+// point, the original vm is left untouched.
+func (p *BackupRestorePoint) RestoreToNewVm(name string, vdc *Vdc) (*TaskHandle, error) {
+	return p.restore(RestoreModeNewVm, name, vdc)
+}
+
+// RestoreToNewDisk creates a disk with the given name in vdc from the
+// restore point, the original disk is left untouched.
+func (p *BackupRestorePoint) RestoreToNewDisk(name string, vdc *Vdc) (*TaskHandle, error) {
+	return p.restore(RestoreModeNewDisk, name, vdc)
+}
+
+func (p *BackupRestorePoint) restore(mode string, name string, vdc *Vdc) (handle *TaskHandle, err error) {
+	path := fmt.Sprintf("v1/backup/restore_point/%s/restore", p.ID)
+	args := &struct {
+		Mode string  `json:"mode"`
+		Name string  `json:"name,omitempty"`
+		Vdc  *string `json:"vdc,omitempty"`
+	}{
+		Mode: mode,
+		Name: name,
+	}
+
+	if vdc != nil {
+		args.Vdc = &vdc.ID
+	}
+
+	taskIds, err := p.manager.requestTasks("POST", path, args, nil)
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] restore-backup-restore-point with id='%s' failed: %s", p.ID, err)
+		return
+	}
+
+	handle = newTaskHandle(p.manager, taskIds)
+	return
+}
+
+func (p *BackupRestorePoint) Delete() (err error) {
+	path := fmt.Sprintf("v1/backup/restore_point/%s", p.ID)
+	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-backup-restore-point failed: %s", err)
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package bcc
+
+// BackupRetention decides how many restore points of a backup job are kept.
+// Either Count keeps the latest restore points, or the GFS counts keep the
+// latest restore point of as many days, weeks, months and years.
+type BackupRetention struct {
+	Count   int `json:"count,omitempty"`
+	Daily   int `json:"daily,omitempty"`
+	Weekly  int `json:"weekly,omitempty"`
+	Monthly int `json:"monthly,omitempty"`
+	Yearly  int `json:"yearly,omitempty"`
+}
+
+func NewBackupRetention(count int) BackupRetention {
+	r := BackupRetention{Count: count}
+	return r
+}
+
+func NewGfsBackupRetention(daily int, weekly int, monthly int, yearly int) BackupRetention {
+	r := BackupRetention{Daily: daily, Weekly: weekly, Monthly: monthly, Yearly: yearly}
+	return r
+}
+
+// Gfs reports whether the retention uses grandfather-father-son rotation.
+func (r *BackupRetention) Gfs() bool {
+	return r.Daily > 0 || r.Weekly > 0 || r.Monthly > 0 || r.Yearly > 0
+}
+
+func (j *BackupJob) SetRetention(retention *BackupRetention) error {
+	j.Retention = retention
+	return j.Update()
+}
@@ -0,0 +1,129 @@
+package bcc
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	BillingReportFormatCsv  = "csv"
+	BillingReportFormatXlsx = "xlsx"
+
+	BillingReportStatusPending = "pending"
+	BillingReportStatusReady   = "ready"
+	BillingReportStatusError   = "error"
+)
+
+type BillingReport struct {
+	manager *Manager
+	ID      string    `json:"id"`
+	Format  string    `json:"format"`
+	Status  string    `json:"status"`
+	Project *MetaData `json:"project,omitempty"`
+	From    Time      `json:"from"`
+	To      Time      `json:"to"`
+}
+
+// ExportBillingReport builds the usage report of all projects of the client
+// over period and writes it to w once it is ready.
+func (c *Client) ExportBillingReport(period UsagePeriod, format string, w io.Writer) (err error) {
+	report, err := createBillingReport(c.manager, c.ID, nil, period, format)
+	if err != nil || report.manager.DryRun {
+		return
+	}
+
+	if err = report.WaitReady(); err != nil {
+		return
+	}
+
+	return report.Download(w)
+}
+
+// ExportBillingReport builds the usage report of the project over period and
+// writes it to w once it is ready.
+func (p *Project) ExportBillingReport(period UsagePeriod, format string, w io.Writer) (err error) {
+	report, err := createBillingReport(p.manager, p.Client.Id, &p.ID, period, format)
+	if err != nil || report.manager.DryRun {
+		return
+	}
+
+	if err = report.WaitReady(); err != nil {
+		return
+	}
+
+	return report.Download(w)
+}
+
+func createBillingReport(m *Manager, clientId string, projectId *string, period UsagePeriod, format string) (report *BillingReport, err error) {
+	path := "v1/billing/report"
+	args := &struct {
+		Client  string  `json:"client"`
+		Project *string `json:"project,omitempty"`
+		Format  string  `json:"format"`
+		From    string  `json:"from"`
+		To      string  `json:"to"`
+	}{
+		Client:  clientId,
+		Project: projectId,
+		Format:  format,
+		From:    period.From.UTC().Format(time.RFC3339),
+		To:      period.To.UTC().Format(time.RFC3339),
+	}
+
+	report = &BillingReport{}
+	if err = m.Request("POST", path, args, report); err != nil {
+		log.Printf("[REQUEST-ERROR] create-billing-report failed: %s", err)
+	} else {
+		report.manager = m
+	}
+
+	return
+}
+
+func (r *BillingReport) Reload() (err error) {
+	path, _ := url.JoinPath("v1/billing/report", r.ID)
+	m := r.manager
+
+	if err = m.Get(path, Defaults(), r); err != nil {
+		log.Printf("[REQUEST-ERROR] get-billing-report with id='%s' failed: %s", r.ID, err)
+	} else {
+		r.manager = m
+	}
+
+	return
+}
+
+// WaitReady polls the report until the platform has built it, a failed
+// report is returned as an error.
+func (r *BillingReport) WaitReady() error {
+	for {
+		if err := r.Reload(); err != nil {
+			return err
+		}
+		switch r.Status {
+		case BillingReportStatusReady:
+			return nil
+		case BillingReportStatusError:
+			return errors.Errorf("billing report '%s' failed", r.ID)
+		}
+
+		if err := r.manager.sleep(RetryTime * time.Millisecond); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *BillingReport) Download(w io.Writer) (err error) {
+	path := fmt.Sprintf("v1/billing/report/%s/download", r.ID)
+
+	if err = r.manager.Download(path, w); err != nil {
+		log.Printf("[REQUEST-ERROR] download-billing-report with id='%s' failed: %s", r.ID, err)
+	}
+
+	return
+}
@@ -5,12 +5,24 @@ import (
 	"net/url"
 )
 
+type ClientContract struct {
+	ID       string  `json:"id"`
+	Number   string  `json:"number"`
+	Balance  float32 `json:"balance"`
+	Currency string  `json:"currency"`
+}
+
 type Client struct {
 	manager      *Manager
-	ID           string  `json:"id"`
-	Name         string  `json:"name"`
-	PaymentModel string  `json:"payment_model"`
-	Balance      float32 `json:"contract.balance"`
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	PaymentModel string          `json:"payment_model"`
+	Contract     *ClientContract `json:"contract"`
+
+	AllowedHypervisors []*Hypervisor `json:"allowed_hypervisors"`
+
+	// Deprecated: Balance is kept for compatibility, use Contract.Balance.
+	Balance float32 `json:"-"`
 }
 
 func (m *Manager) GetClients(extraArgs ...Arguments) (clients []*Client, err error) {
@@ -22,7 +34,7 @@ func (m *Manager) GetClients(extraArgs ...Arguments) (clients []*Client, err err
 		log.Printf("[REQUEST-ERROR] get-client list failed: %s", err)
 	} else {
 		for i := range clients {
-			clients[i].manager = m
+			clients[i].setManager(m)
 		}
 	}
 
@@ -35,8 +47,18 @@ func (m *Manager) GetClient(id string) (client *Client, err error) {
 	if err = m.Get(path, Defaults(), &client); err != nil {
 		log.Printf("[REQUEST-ERROR] get-client with id='%s' failed: %s", id, err)
 	} else {
-		client.manager = m
+		client.setManager(m)
 	}
 
 	return
 }
+
+func (c *Client) setManager(m *Manager) {
+	c.manager = m
+	for _, hypervisor := range c.AllowedHypervisors {
+		hypervisor.manager = m
+	}
+	if c.Contract != nil {
+		c.Balance = c.Contract.Balance
+	}
+}
@@ -0,0 +1,68 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+// LdapSettings lets users of the client log in with their domain accounts.
+type LdapSettings struct {
+	Enabled bool   `json:"enabled"`
+	Domain  string `json:"domain"`
+	Url     string `json:"url"`
+	BindDn  string `json:"bind_dn"`
+	// BindPassword is never returned, leave it empty to keep the stored one.
+	BindPassword string `json:"bind_password,omitempty"`
+	BaseDn       string `json:"base_dn"`
+	UserFilter   string `json:"user_filter,omitempty"`
+	GroupFilter  string `json:"group_filter,omitempty"`
+	StartTls     bool   `json:"start_tls"`
+}
+
+func NewLdapSettings(domain string, ldapUrl string, bindDn string, bindPassword string, baseDn string) LdapSettings {
+	s := LdapSettings{Enabled: true, Domain: domain, Url: ldapUrl, BindDn: bindDn, BindPassword: bindPassword, BaseDn: baseDn}
+	return s
+}
+
+func (c *Client) GetLdapSettings() (settings *LdapSettings, err error) {
+	path := fmt.Sprintf("v1/client/%s/ldap", c.ID)
+	settings = &LdapSettings{}
+
+	if err = c.manager.Get(path, Defaults(), settings); err != nil {
+		log.Printf("[REQUEST-ERROR] get-client-ldap failed: %s", err)
+	}
+
+	return
+}
+
+func (c *Client) SetLdapSettings(settings *LdapSettings) (err error) {
+	path := fmt.Sprintf("v1/client/%s/ldap", c.ID)
+
+	if err = c.manager.Request("PUT", path, settings, settings); err != nil {
+		log.Printf("[REQUEST-ERROR] set-client-ldap failed: %s", err)
+	}
+
+	return
+}
+
+// TestLdapSettings checks the settings can bind to the directory without
+// saving them.
+func (c *Client) TestLdapSettings(settings *LdapSettings) (err error) {
+	path := fmt.Sprintf("v1/client/%s/ldap/test", c.ID)
+
+	if err = c.manager.Request("POST", path, settings, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] test-client-ldap failed: %s", err)
+	}
+
+	return
+}
+
+func (c *Client) DisableLdap() (err error) {
+	settings, err := c.GetLdapSettings()
+	if err != nil {
+		return
+	}
+
+	settings.Enabled = false
+	return c.SetLdapSettings(settings)
+}
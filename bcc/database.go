@@ -0,0 +1,224 @@
+package bcc
+
+import (
+	"log"
+	"net/url"
+)
+
+const (
+	DatabaseEnginePostgresql = "postgresql"
+	DatabaseEngineMysql      = "mysql"
+	DatabaseEngineRedis      = "redis"
+)
+
+type DatabaseFlavor struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Engine string  `json:"engine"`
+	Cpu    int     `json:"cpu"`
+	Ram    float64 `json:"ram"`
+}
+
+type Database struct {
+	manager *Manager
+	ID      string          `json:"id"`
+	Name    string          `json:"name"`
+	Engine  string          `json:"engine"`
+	Version string          `json:"version"`
+	Flavor  *DatabaseFlavor `json:"flavor"`
+
+	DiskSize       int             `json:"disk_size"`
+	StorageProfile *StorageProfile `json:"storage_profile"`
+	Network        *Network        `json:"network"`
+	Floating       *Port           `json:"floating,omitempty"`
+
+	Host   string `json:"host"`
+	Port   int    `json:"port"`
+	Status string `json:"status"`
+
+	Vdc    *Vdc   `json:"vdc"`
+	Locked bool   `json:"locked"`
+	JobId  string `json:"job_id"`
+	Tags   []Tag  `json:"tags"`
+}
+
+func NewDatabase(name string, engine string, version string, flavor *DatabaseFlavor, diskSize int, storageProfile *StorageProfile, network *Network) Database {
+	d := Database{Name: name, Engine: engine, Version: version, Flavor: flavor, DiskSize: diskSize, StorageProfile: storageProfile, Network: network}
+	return d
+}
+
+func (m *Manager) GetDatabaseFlavors(extraArgs ...Arguments) (flavors []*DatabaseFlavor, err error) {
+	path := "v1/dbaas/flavor"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &flavors); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database-flavor list failed: %s", err)
+	}
+
+	return
+}
+
+func (v *Vdc) GetDatabaseFlavors(extraArgs ...Arguments) (flavors []*DatabaseFlavor, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	flavors, err = v.manager.GetDatabaseFlavors(args)
+	return
+}
+
+func (m *Manager) GetDatabases(extraArgs ...Arguments) (databases []*Database, err error) {
+	path := "v1/dbaas"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &databases); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database list failed: %s", err)
+	} else {
+		for i := range databases {
+			databases[i].setManager(m)
+		}
+	}
+
+	return
+}
+
+func (v *Vdc) GetDatabases(extraArgs ...Arguments) (databases []*Database, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	databases, err = v.manager.GetDatabases(args)
+	return
+}
+
+func (m *Manager) GetDatabase(id string) (database *Database, err error) {
+	path, _ := url.JoinPath("v1/dbaas", id)
+
+	if err = m.Get(path, Defaults(), &database); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database failed: %s", err)
+	} else {
+		database.setManager(m)
+	}
+
+	return
+}
+
+// CreateDatabase deploys a managed database instance and returns once the
+// instance is ready to accept connections.
+func (v *Vdc) CreateDatabase(database *Database) (err error) {
+	path := "v1/dbaas"
+	args := &struct {
+		Name           string   `json:"name"`
+		Vdc            string   `json:"vdc"`
+		Engine         string   `json:"engine"`
+		Version        string   `json:"version"`
+		Flavor         string   `json:"flavor"`
+		DiskSize       int      `json:"disk_size"`
+		StorageProfile string   `json:"storage_profile"`
+		Network        string   `json:"network"`
+		Floating       *string  `json:"floating"`
+		Tags           []string `json:"tags"`
+	}{
+		Name:           database.Name,
+		Vdc:            v.ID,
+		Engine:         database.Engine,
+		Version:        database.Version,
+		Flavor:         database.Flavor.ID,
+		DiskSize:       database.DiskSize,
+		StorageProfile: database.StorageProfile.ID,
+		Network:        database.Network.ID,
+		Tags:           convertTagsToNames(database.Tags),
+	}
+
+	if database.Floating != nil {
+		if database.Floating.ID != "" {
+			args.Floating = &database.Floating.ID
+		} else {
+			args.Floating = database.Floating.IpAddress
+		}
+	}
+
+	if err = v.manager.Request("POST", path, args, &database); err != nil {
+		log.Printf("[REQUEST-ERROR] create-database failed: %s", err)
+		return
+	}
+
+	database.setManager(v.manager)
+	if v.manager.DryRun {
+		return
+	}
+
+	if err = database.WaitLock(); err != nil {
+		log.Printf("[REQUEST-ERROR] wait-lock for database '%s' failed: %s", database.ID, err)
+		return
+	}
+
+	return database.Reload()
+}
+
+// Update applies name, flavor, disk size and tag changes. The disk can only
+// grow.
+func (d *Database) Update() (err error) {
+	path, _ := url.JoinPath("v1/dbaas", d.ID)
+	args := &struct {
+		Name     string   `json:"name"`
+		Flavor   string   `json:"flavor"`
+		DiskSize int      `json:"disk_size"`
+		Tags     []string `json:"tags"`
+	}{
+		Name:     d.Name,
+		Flavor:   d.Flavor.ID,
+		DiskSize: d.DiskSize,
+		Tags:     convertTagsToNames(d.Tags),
+	}
+
+	m := d.manager
+	if err = m.Request("PUT", path, args, d); err != nil {
+		log.Printf("[REQUEST-ERROR] update-database failed: %s", err)
+		return
+	}
+
+	d.setManager(m)
+	return d.WaitLock()
+}
+
+func (d *Database) Reload() (err error) {
+	path, _ := url.JoinPath("v1/dbaas", d.ID)
+	m := d.manager
+
+	if err = m.Get(path, Defaults(), &d); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database failed: %s", err)
+	} else {
+		d.setManager(m)
+	}
+
+	return
+}
+
+func (d *Database) setManager(m *Manager) {
+	d.manager = m
+	if d.Vdc != nil {
+		d.Vdc.manager = m
+	}
+	if d.Network != nil {
+		d.Network.manager = m
+	}
+	if d.Floating != nil {
+		d.Floating.manager = m
+	}
+}
+
+func (d *Database) Delete() (err error) {
+	path, _ := url.JoinPath("v1/dbaas", d.ID)
+	if err = d.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-database failed: %s", err)
+	}
+	return
+}
+
+func (d Database) WaitLock() (err error) {
+	path, _ := url.JoinPath("v1/dbaas", d.ID)
+	return loopWaitLock(d.manager, path)
+}
@@ -0,0 +1,112 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	DatabaseRestorePointFull        = "full"
+	DatabaseRestorePointIncremental = "incremental"
+)
+
+type DatabaseBackupConfig struct {
+	Enabled bool `json:"enabled"`
+	// StartTime is the daily backup window start in HH:MM, UTC.
+	StartTime     string `json:"start_time"`
+	RetentionDays int    `json:"retention_days"`
+	// PointInTime keeps the write-ahead log between backups, so the instance
+	// can be restored to any moment of the retention period.
+	PointInTime bool `json:"point_in_time"`
+}
+
+type DatabaseRestorePoint struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Size      int64  `json:"size"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+func NewDatabaseBackupConfig(startTime string, retentionDays int, pointInTime bool) DatabaseBackupConfig {
+	c := DatabaseBackupConfig{Enabled: true, StartTime: startTime, RetentionDays: retentionDays, PointInTime: pointInTime}
+	return c
+}
+
+func (d *Database) GetBackupConfig() (config *DatabaseBackupConfig, err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/backup_config", d.ID)
+	config = &DatabaseBackupConfig{}
+
+	if err = d.manager.Get(path, Defaults(), config); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database-backup-config failed: %s", err)
+	}
+
+	return
+}
+
+func (d *Database) SetBackupConfig(config *DatabaseBackupConfig) (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/backup_config", d.ID)
+
+	if err = d.manager.Request("PUT", path, config, config); err != nil {
+		log.Printf("[REQUEST-ERROR] set-database-backup-config failed: %s", err)
+	}
+
+	return
+}
+
+func (d *Database) GetRestorePoints(extraArgs ...Arguments) (points []*DatabaseRestorePoint, err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/restore_point", d.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = d.manager.GetItems(path, args, &points); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database-restore-point list failed: %s", err)
+	}
+
+	return
+}
+
+// RestoreFromPoint creates a new instance with the given name from the
+// restore point. The source instance is left untouched.
+func (d *Database) RestoreFromPoint(name string, point *DatabaseRestorePoint) (*Database, error) {
+	return d.restore(name, &point.ID, nil)
+}
+
+// RestoreToTime creates a new instance with the given name with the data as
+// of at. Point in time recovery has to be enabled in the backup config.
+func (d *Database) RestoreToTime(name string, at time.Time) (*Database, error) {
+	pointInTime := at.UTC().Format(time.RFC3339)
+	return d.restore(name, nil, &pointInTime)
+}
+
+func (d *Database) restore(name string, restorePoint *string, pointInTime *string) (database *Database, err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/restore", d.ID)
+	args := &struct {
+		Name         string  `json:"name"`
+		RestorePoint *string `json:"restore_point,omitempty"`
+		PointInTime  *string `json:"point_in_time,omitempty"`
+	}{
+		Name:         name,
+		RestorePoint: restorePoint,
+		PointInTime:  pointInTime,
+	}
+
+	database = &Database{}
+	if err = d.manager.Request("POST", path, args, database); err != nil {
+		log.Printf("[REQUEST-ERROR] restore-database failed: %s", err)
+		return
+	}
+
+	database.setManager(d.manager)
+	if d.manager.DryRun {
+		return
+	}
+
+	if err = database.WaitLock(); err != nil {
+		log.Printf("[REQUEST-ERROR] wait-lock for database '%s' failed: %s", database.ID, err)
+		return
+	}
+
+	err = database.Reload()
+	return
+}
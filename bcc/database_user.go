@@ -0,0 +1,207 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+const (
+	DatabasePrivilegeRead  = "read"
+	DatabasePrivilegeWrite = "write"
+	DatabasePrivilegeOwner = "owner"
+)
+
+// DatabaseSchema is a database inside a managed database instance.
+type DatabaseSchema struct {
+	manager    *Manager
+	databaseId string
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Owner      string `json:"owner,omitempty"`
+	Encoding   string `json:"encoding,omitempty"`
+}
+
+type DatabaseGrant struct {
+	Schema    string `json:"database"`
+	Privilege string `json:"privilege"`
+}
+
+type DatabaseUser struct {
+	manager    *Manager
+	databaseId string
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	// Password is only returned when the user is created or the password is
+	// reset. Leave it empty on create to have one generated.
+	Password string           `json:"password,omitempty"`
+	Grants   []*DatabaseGrant `json:"grants"`
+}
+
+func NewDatabaseSchema(name string, owner string) DatabaseSchema {
+	s := DatabaseSchema{Name: name, Owner: owner}
+	return s
+}
+
+func NewDatabaseUser(name string, password string) DatabaseUser {
+	u := DatabaseUser{Name: name, Password: password}
+	return u
+}
+
+func (d *Database) GetSchemas(extraArgs ...Arguments) (schemas []*DatabaseSchema, err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/database", d.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = d.manager.GetItems(path, args, &schemas); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database-schema list failed: %s", err)
+	} else {
+		for i := range schemas {
+			schemas[i].manager = d.manager
+			schemas[i].databaseId = d.ID
+		}
+	}
+
+	return
+}
+
+func (d *Database) CreateSchema(schema *DatabaseSchema) (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/database", d.ID)
+	args := &struct {
+		Name     string `json:"name"`
+		Owner    string `json:"owner,omitempty"`
+		Encoding string `json:"encoding,omitempty"`
+	}{
+		Name:     schema.Name,
+		Owner:    schema.Owner,
+		Encoding: schema.Encoding,
+	}
+
+	if err = d.manager.Request("POST", path, args, &schema); err != nil {
+		log.Printf("[REQUEST-ERROR] create-database-schema failed: %s", err)
+	} else {
+		schema.manager = d.manager
+		schema.databaseId = d.ID
+	}
+
+	return
+}
+
+func (s *DatabaseSchema) Delete() (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/database/%s", s.databaseId, s.ID)
+	if err = s.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-database-schema failed: %s", err)
+	}
+	return
+}
+
+func (d *Database) GetUsers(extraArgs ...Arguments) (users []*DatabaseUser, err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/user", d.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = d.manager.GetItems(path, args, &users); err != nil {
+		log.Printf("[REQUEST-ERROR] get-database-user list failed: %s", err)
+	} else {
+		for i := range users {
+			users[i].manager = d.manager
+			users[i].databaseId = d.ID
+		}
+	}
+
+	return
+}
+
+func (d *Database) CreateUser(user *DatabaseUser) (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/user", d.ID)
+	args := &struct {
+		Name     string           `json:"name"`
+		Password string           `json:"password,omitempty"`
+		Grants   []*DatabaseGrant `json:"grants"`
+	}{
+		Name:     user.Name,
+		Password: user.Password,
+		Grants:   user.Grants,
+	}
+	if args.Grants == nil {
+		args.Grants = []*DatabaseGrant{}
+	}
+
+	if err = d.manager.Request("POST", path, args, &user); err != nil {
+		log.Printf("[REQUEST-ERROR] create-database-user failed: %s", err)
+	} else {
+		user.manager = d.manager
+		user.databaseId = d.ID
+		if args.Password != "" {
+			user.Password = args.Password
+		}
+	}
+
+	return
+}
+
+// Grant gives the user the privilege on the schema, an existing grant on the
+// same schema is replaced.
+func (u *DatabaseUser) Grant(schema *DatabaseSchema, privilege string) (err error) {
+	grants := make([]*DatabaseGrant, 0, len(u.Grants)+1)
+	for _, grant := range u.Grants {
+		if grant.Schema != schema.Name {
+			grants = append(grants, grant)
+		}
+	}
+	grants = append(grants, &DatabaseGrant{Schema: schema.Name, Privilege: privilege})
+
+	return u.setGrants(grants)
+}
+
+func (u *DatabaseUser) Revoke(schema *DatabaseSchema) (err error) {
+	grants := make([]*DatabaseGrant, 0, len(u.Grants))
+	for _, grant := range u.Grants {
+		if grant.Schema != schema.Name {
+			grants = append(grants, grant)
+		}
+	}
+
+	return u.setGrants(grants)
+}
+
+func (u *DatabaseUser) setGrants(grants []*DatabaseGrant) (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/user/%s", u.databaseId, u.ID)
+	args := &struct {
+		Grants []*DatabaseGrant `json:"grants"`
+	}{
+		Grants: grants,
+	}
+
+	if err = u.manager.Request("PUT", path, args, u); err != nil {
+		log.Printf("[REQUEST-ERROR] update-database-user failed: %s", err)
+	}
+
+	return
+}
+
+// ResetPassword sets a new password for the user. An empty password asks the
+// platform to generate one, it is stored in Password afterwards.
+func (u *DatabaseUser) ResetPassword(password string) (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/user/%s/reset_password", u.databaseId, u.ID)
+	args := &struct {
+		Password string `json:"password,omitempty"`
+	}{
+		Password: password,
+	}
+
+	if err = u.manager.Request("POST", path, args, u); err != nil {
+		log.Printf("[REQUEST-ERROR] reset-database-user-password failed: %s", err)
+	} else if password != "" {
+		u.Password = password
+	}
+
+	return
+}
+
+func (u *DatabaseUser) Delete() (err error) {
+	path := fmt.Sprintf("v1/dbaas/%s/user/%s", u.databaseId, u.ID)
+	if err = u.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-database-user failed: %s", err)
+	}
+	return
+}
@@ -18,18 +18,36 @@ type TmpVm struct {
 }
 
 type Disk struct {
-	manager        *Manager
-	ID             string          `json:"id"`
-	Name           string          `json:"name"`
-	Scsi           string          `json:"scsi"`
-	ExternalID     string          `json:"external_id"`
-	IsRoot         bool            `json:"is_root"`
-	Size           int             `json:"size"`
-	Vdc            *Vdc            `json:"vdc,omitempty"`
-	Vm             *TmpVm          `json:"vm"`
-	StorageProfile *StorageProfile `json:"storage_profile"`
-	Locked         bool            `json:"locked,omitempty"`
-	Tags           []Tag           `json:"tags"`
+	manager          *Manager
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Scsi             string          `json:"scsi"`
+	ExternalID       string          `json:"external_id"`
+	IsRoot           bool            `json:"is_root"`
+	Size             int             `json:"size"`
+	Vdc              *Vdc            `json:"vdc,omitempty"`
+	Vm               *TmpVm          `json:"vm"`
+	Vms              []*TmpVm        `json:"vms,omitempty"`
+	Shared           bool            `json:"shared"`
+	StorageProfile   *StorageProfile `json:"storage_profile"`
+	Locked           bool            `json:"locked,omitempty"`
+	Tags             []Tag           `json:"tags"`
+	Encrypted        bool            `json:"encrypted"`
+	EncryptionKey    *string         `json:"encryption_key,omitempty"`
+	EncryptionStatus string          `json:"encryption_status,omitempty"`
+	IopsLimit        *int            `json:"iops_limit,omitempty"`
+	BandwidthLimit   *int            `json:"bandwidth_limit,omitempty"`
+}
+
+type DiskPatch struct {
+	Name           *string   `json:"name,omitempty"`
+	Size           *int      `json:"size,omitempty"`
+	StorageProfile *string   `json:"storage_profile,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+	Encrypted      *bool     `json:"encrypted,omitempty"`
+	EncryptionKey  *string   `json:"encryption_key,omitempty"`
+	IopsLimit      *int      `json:"iops_limit,omitempty"`
+	BandwidthLimit *int      `json:"bandwidth_limit,omitempty"`
 }
 
 func NewDisk(name string, size int, storageProfile *StorageProfile) Disk {
@@ -83,6 +101,11 @@ func (v *Vdc) CreateDisk(disk *Disk) (err error) {
 		Size           int      `json:"size"`
 		StorageProfile string   `json:"storage_profile"`
 		Tags           []string `json:"tags"`
+		Encrypted      bool     `json:"encrypted,omitempty"`
+		EncryptionKey  *string  `json:"encryption_key,omitempty"`
+		IopsLimit      *int     `json:"iops_limit,omitempty"`
+		BandwidthLimit *int     `json:"bandwidth_limit,omitempty"`
+		Shared         bool     `json:"shared,omitempty"`
 	}{
 		Name:           disk.Name,
 		Vdc:            &v.ID,
@@ -90,6 +113,11 @@ func (v *Vdc) CreateDisk(disk *Disk) (err error) {
 		Size:           disk.Size,
 		StorageProfile: disk.StorageProfile.ID,
 		Tags:           convertTagsToNames(disk.Tags),
+		Encrypted:      disk.Encrypted,
+		EncryptionKey:  disk.EncryptionKey,
+		IopsLimit:      disk.IopsLimit,
+		BandwidthLimit: disk.BandwidthLimit,
+		Shared:         disk.Shared,
 	}
 
 	if disk.Vm != nil {
@@ -106,13 +134,40 @@ func (v *Vdc) CreateDisk(disk *Disk) (err error) {
 	return
 }
 
-func (v *Vm) AttachDisk(disk *Disk) (err error) {
+const (
+	DiskBusVirtio = "virtio"
+	DiskBusScsi   = "scsi"
+	DiskBusIde    = "ide"
+)
+
+type AttachDiskOption func(*attachDiskArgs)
+
+type attachDiskArgs struct {
+	Vm   string `json:"vm"`
+	Bus  string `json:"bus,omitempty"`
+	Unit *int   `json:"unit,omitempty"`
+}
+
+// WithDiskBus selects the controller the disk is attached to.
+func WithDiskBus(bus string) AttachDiskOption {
+	return func(args *attachDiskArgs) {
+		args.Bus = bus
+	}
+}
+
+// WithDiskUnit selects the unit number of the disk on its controller.
+func WithDiskUnit(unit int) AttachDiskOption {
+	return func(args *attachDiskArgs) {
+		args.Unit = &unit
+	}
+}
+
+func (v *Vm) AttachDisk(disk *Disk, opts ...AttachDiskOption) (err error) {
 	path := fmt.Sprintf("v1/disk/%s/attach", disk.ID)
 
-	args := &struct {
-		Vm string `json:"vm"`
-	}{
-		Vm: v.ID,
+	args := &attachDiskArgs{Vm: v.ID}
+	for _, opt := range opts {
+		opt(args)
 	}
 
 	if err = v.manager.Request("POST", path, args, nil); err != nil {
@@ -124,10 +179,21 @@ func (v *Vm) AttachDisk(disk *Disk) (err error) {
 	return
 }
 
+// DetachDisk detaches the disk from the vm. Shared disks stay attached to
+// their other vms.
 func (v *Vm) DetachDisk(disk *Disk) (err error) {
 	path := fmt.Sprintf("v1/disk/%s/detach", disk.ID)
 
-	if err = v.manager.Request("POST", path, nil, nil); err != nil {
+	var args interface{}
+	if disk.Shared {
+		args = &struct {
+			Vm string `json:"vm"`
+		}{
+			Vm: v.ID,
+		}
+	}
+
+	if err = v.manager.Request("POST", path, args, nil); err != nil {
 		log.Printf("[REQUEST-ERROR] detach-disk with id='%s' failed: %s", disk.ID, err)
 	} else {
 		for i, vmDisk := range v.Disks {
@@ -141,6 +207,18 @@ func (v *Vm) DetachDisk(disk *Disk) (err error) {
 	return
 }
 
+// AttachedVms returns every vm the disk is attached to, which is more than
+// one only for shared disks.
+func (d *Disk) AttachedVms() []*TmpVm {
+	if len(d.Vms) > 0 {
+		return d.Vms
+	}
+	if d.Vm != nil {
+		return []*TmpVm{d.Vm}
+	}
+	return nil
+}
+
 func (d *Disk) UpdateStorageProfile(storageProfile StorageProfile) (err error) {
 	d.StorageProfile = &storageProfile
 
@@ -148,7 +226,7 @@ func (d *Disk) UpdateStorageProfile(storageProfile StorageProfile) (err error) {
 		log.Printf("[REQUEST-ERROR]: update-storageProfile failed %s", err)
 	}
 
-	return nil
+	return
 }
 
 func (d *Disk) Update() (err error) {
@@ -159,18 +237,36 @@ func (d *Disk) Update() (err error) {
 		Size           int      `json:"size"`
 		StorageProfile string   `json:"storage_profile"`
 		Tags           []string `json:"tags"`
+		Encrypted      bool     `json:"encrypted"`
+		EncryptionKey  *string  `json:"encryption_key,omitempty"`
+		IopsLimit      *int     `json:"iops_limit,omitempty"`
+		BandwidthLimit *int     `json:"bandwidth_limit,omitempty"`
 	}{
 		Name:           d.Name,
 		Size:           d.Size,
 		StorageProfile: d.StorageProfile.ID,
 		Tags:           convertTagsToNames(d.Tags),
+		Encrypted:      d.Encrypted,
+		EncryptionKey:  d.EncryptionKey,
+		IopsLimit:      d.IopsLimit,
+		BandwidthLimit: d.BandwidthLimit,
 	}
 
 	if err = d.manager.Request("PUT", path, args, d); err != nil {
 		log.Printf("[REQUEST-ERROR] update-disk with id='%s' failed: %s", d.ID, err)
 	}
 
-	return nil
+	return
+}
+
+func (d *Disk) Patch(patch DiskPatch) (err error) {
+	path, _ := url.JoinPath("v1/disk", d.ID)
+
+	if err = d.manager.Patch(path, patch, d); err != nil {
+		log.Printf("[REQUEST-ERROR] patch-disk with id='%s' failed: %s", d.ID, err)
+	}
+
+	return
 }
 
 func (d *Disk) Rename(name string) error {
@@ -207,3 +303,38 @@ func (d Disk) WaitLock() (err error) {
 
 	return
 }
+
+// Encrypt enables encryption of the disk with the given key management key,
+// or with the platform default key when keyId is nil.
+func (d *Disk) Encrypt(keyId *string) (err error) {
+	encrypted := true
+	return d.Patch(DiskPatch{Encrypted: &encrypted, EncryptionKey: keyId})
+}
+
+// SetLimits caps the disk at iops operations and bandwidth MB per second.
+// Zero removes the corresponding limit.
+func (d *Disk) SetLimits(iops int, bandwidth int) error {
+	return d.Patch(DiskPatch{IopsLimit: &iops, BandwidthLimit: &bandwidth})
+}
+
+// Migrate moves the disk to storageProfile and returns right away, so the
+// possibly long migration can be tracked through the returned handle. The
+// disk is left as is, Reload it once the handle is done.
+func (d *Disk) Migrate(storageProfile *StorageProfile) (handle *TaskHandle, err error) {
+	path := fmt.Sprintf("v1/disk/%s/migrate", d.ID)
+
+	args := &struct {
+		StorageProfile string `json:"storage_profile"`
+	}{
+		StorageProfile: storageProfile.ID,
+	}
+
+	taskIds, err := d.manager.requestTasks("POST", path, args, nil)
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] migrate-disk with id='%s' failed: %s", d.ID, err)
+		return
+	}
+
+	handle = newTaskHandle(d.manager, taskIds)
+	return
+}
@@ -0,0 +1,36 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+// Clone copies the disk into a new detached disk in the same vdc. A nil
+// storageProfile keeps the storage profile of the source disk.
+func (d *Disk) Clone(name string, storageProfile *StorageProfile) (disk *Disk, err error) {
+	path := fmt.Sprintf("v1/disk/%s/clone", d.ID)
+
+	args := &struct {
+		Name           string  `json:"name"`
+		StorageProfile *string `json:"storage_profile,omitempty"`
+	}{
+		Name: name,
+	}
+	if storageProfile != nil {
+		args.StorageProfile = &storageProfile.ID
+	}
+
+	disk = &Disk{}
+	if err = d.manager.Request("POST", path, args, disk); err != nil {
+		log.Printf("[REQUEST-ERROR] clone-disk with id='%s' failed: %s", d.ID, err)
+		return
+	}
+
+	disk.manager = d.manager
+	if d.manager.DryRun {
+		return
+	}
+
+	err = disk.WaitLock()
+	return
+}
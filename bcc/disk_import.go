@@ -0,0 +1,47 @@
+package bcc
+
+import "log"
+
+// CreateDiskFromURL makes the platform download a qcow2, vmdk or raw image
+// from sourceUrl and import it as a new disk of the vdc.
+func (v *Vdc) CreateDiskFromURL(name string, sourceUrl string, storageProfile *StorageProfile) (disk *Disk, err error) {
+	return v.importDisk(name, storageProfile, sourceUrl, "")
+}
+
+// CreateDiskFromImage imports an image which was already uploaded to the
+// platform as a new disk of the vdc.
+func (v *Vdc) CreateDiskFromImage(name string, imageId string, storageProfile *StorageProfile) (disk *Disk, err error) {
+	return v.importDisk(name, storageProfile, "", imageId)
+}
+
+func (v *Vdc) importDisk(name string, storageProfile *StorageProfile, sourceUrl string, imageId string) (disk *Disk, err error) {
+	path := "v1/disk/import"
+
+	args := &struct {
+		Name           string `json:"name"`
+		Vdc            string `json:"vdc"`
+		StorageProfile string `json:"storage_profile"`
+		Url            string `json:"url,omitempty"`
+		Image          string `json:"image,omitempty"`
+	}{
+		Name:           name,
+		Vdc:            v.ID,
+		StorageProfile: storageProfile.ID,
+		Url:            sourceUrl,
+		Image:          imageId,
+	}
+
+	disk = &Disk{}
+	if err = v.manager.Request("POST", path, args, disk); err != nil {
+		log.Printf("[REQUEST-ERROR] import-disk failed: %s", err)
+		return
+	}
+
+	disk.manager = v.manager
+	if v.manager.DryRun {
+		return
+	}
+
+	err = disk.WaitLock()
+	return
+}
@@ -5,6 +5,17 @@ import (
 	"log"
 )
 
+const (
+	DnsRecordTypeA     = "A"
+	DnsRecordTypeAAAA  = "AAAA"
+	DnsRecordTypeCNAME = "CNAME"
+	DnsRecordTypeMX    = "MX"
+	DnsRecordTypeTXT   = "TXT"
+	DnsRecordTypeSRV   = "SRV"
+	DnsRecordTypeCAA   = "CAA"
+	DnsRecordTypeNS    = "NS"
+)
+
 type DnsRecord struct {
 	manager  *Manager
 	DnsZone  string
@@ -25,6 +36,23 @@ func NewDnsRecord(data string, flag int, host string, port int, priority int, ta
 	return d
 }
 
+// NewSimpleDnsRecord builds a record which only needs host and data, such as
+// A, AAAA, CNAME, TXT or NS.
+func NewSimpleDnsRecord(recordType string, host string, data string, ttl int) DnsRecord {
+	d := DnsRecord{Type: recordType, Host: host, Data: data, Ttl: ttl}
+	return d
+}
+
+func NewMxDnsRecord(host string, data string, priority int, ttl int) DnsRecord {
+	d := DnsRecord{Type: DnsRecordTypeMX, Host: host, Data: data, Priority: priority, Ttl: ttl}
+	return d
+}
+
+func NewSrvDnsRecord(host string, data string, priority int, weight int, port int, ttl int) DnsRecord {
+	d := DnsRecord{Type: DnsRecordTypeSRV, Host: host, Data: data, Priority: priority, Weight: weight, Port: port, Ttl: ttl}
+	return d
+}
+
 func (m *Manager) GetDnsRecords(dnsId string, extraArgs ...Arguments) (dnsRecord []*DnsRecord, err error) {
 	path := fmt.Sprintf("v1/dns/%s/dns_record", dnsId)
 	args := Defaults()
@@ -35,6 +63,7 @@ func (m *Manager) GetDnsRecords(dnsId string, extraArgs ...Arguments) (dnsRecord
 	} else {
 		for i := range dnsRecord {
 			dnsRecord[i].manager = m
+			dnsRecord[i].DnsZone = dnsId
 		}
 	}
 
@@ -46,6 +75,27 @@ func (d *Dns) GetDnsRecords(extraArgs ...Arguments) (dnsRecord []*DnsRecord, err
 	return
 }
 
+// FindDnsRecords returns the records of the zone with the given type and
+// host, an empty value matches any.
+func (d *Dns) FindDnsRecords(recordType string, host string) (dnsRecords []*DnsRecord, err error) {
+	records, err := d.GetDnsRecords()
+	if err != nil {
+		return
+	}
+
+	for _, record := range records {
+		if recordType != "" && record.Type != recordType {
+			continue
+		}
+		if host != "" && record.Host != host {
+			continue
+		}
+		dnsRecords = append(dnsRecords, record)
+	}
+
+	return
+}
+
 func (d *Dns) CreateDnsRecord(dnsRecord *DnsRecord) (err error) {
 	path := fmt.Sprintf("v1/dns/%s/record", d.ID)
 	args := &struct {
@@ -0,0 +1,28 @@
+package bcc
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// WithDryRun returns a manager which logs mutating requests instead of
+// sending them. Read requests are still performed. Calls which create an
+// object return right after the logged request, the object has no ID to wait
+// for or reload.
+func (m *Manager) WithDryRun() *Manager {
+	newManager := *m
+	newManager.DryRun = true
+	return &newManager
+}
+
+func (m *Manager) skipDryRun(req *http.Request, requestBody []byte) bool {
+	if !m.DryRun || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return false
+	}
+
+	log.Printf("[DRY-RUN] %s %s payload: %s", req.Method, req.URL, requestBody)
+	m.recordResult(req, requestBody, nil, time.Now(), 0)
+
+	return true
+}
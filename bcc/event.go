@@ -0,0 +1,141 @@
+package bcc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Event struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	ObjectType string          `json:"object_type"`
+	ObjectID   string          `json:"object_id"`
+	State      string          `json:"state"`
+	Locked     *bool           `json:"locked,omitempty"`
+	Data       json.RawMessage `json:"data"`
+	CreatedAt  Time            `json:"created_at"`
+}
+
+type EventHandler func(event *Event) error
+
+// Subscribe listens to the platform event feed and calls handler for every
+// event until ctx is cancelled or handler returns an error. Dropped
+// connections are resumed from the last received event.
+func (m *Manager) Subscribe(ctx context.Context, handler EventHandler, extraArgs ...Arguments) error {
+	path := "v1/event/stream"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	manager := m.WithContext(ctx)
+	lastEventID := ""
+
+	for {
+		err := manager.readEvents(path, args, &lastEventID, handler)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var apiErr *ApiError
+		var handlerErr *eventHandlerError
+		if errors.As(err, &apiErr) {
+			log.Printf("[REQUEST-ERROR] subscribe-events failed: %s", err)
+			return err
+		}
+		if errors.As(err, &handlerErr) {
+			return handlerErr.err
+		}
+
+		m.log("[bcc] Event stream closed (%v), reconnecting in %dms...", err, RetryTime)
+		if err := SleepWithContext(ctx, RetryTime*time.Millisecond); err != nil {
+			return err
+		}
+	}
+}
+
+type eventHandlerError struct {
+	err error
+}
+
+func (e *eventHandlerError) Error() string { return e.err.Error() }
+
+func (m *Manager) readEvents(path string, args Arguments, lastEventID *string, handler EventHandler) error {
+	requestUrl, _ := url.JoinPath(m.BaseURL, path)
+	urlWithParams := fmt.Sprintf("%s?%s", requestUrl, args.ToURLValues().Encode())
+
+	req, err := http.NewRequest("GET", urlWithParams, nil)
+	if err != nil {
+		log.Printf("Invalid GET request %s", requestUrl)
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.Token))
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+
+	req = req.WithContext(m.ctx)
+
+	resp, err := m.perform(req, requestUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	var id, eventType string
+	var data []string
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if id != "" {
+				*lastEventID = id
+			}
+			if len(data) > 0 {
+				event := &Event{}
+				if err := json.Unmarshal([]byte(strings.Join(data, "\n")), event); err != nil {
+					return errors.Wrapf(err, "JSON event decode failed on %s", requestUrl)
+				}
+				if event.ID == "" {
+					event.ID = id
+				}
+				if event.Type == "" {
+					event.Type = eventType
+				}
+				if err := handler(event); err != nil {
+					return &eventHandlerError{err: err}
+				}
+			}
+			id, eventType, data = "", "", nil
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "id":
+			id = value
+		case "event":
+			eventType = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,202 @@
+package bcc
+
+import (
+	"log"
+
+	"github.com/pkg/errors"
+)
+
+type FirewallBaselineTemplate struct {
+	Name        string
+	Description string
+	Rules       []*FirewallRule
+	// Prune removes rules of the template that are not part of the baseline.
+	Prune bool
+}
+
+type FirewallBaselineResult struct {
+	Vdc          *Vdc
+	Template     *FirewallTemplate
+	Created      bool
+	RulesCreated int
+	RulesUpdated int
+	RulesDeleted int
+	Err          error
+}
+
+func NewFirewallBaselineTemplate(name string, description string, rules []*FirewallRule, prune bool) FirewallBaselineTemplate {
+	b := FirewallBaselineTemplate{Name: name, Description: description, Rules: rules, Prune: prune}
+	return b
+}
+
+// ApplyFirewallBaseline makes sure every vdc of the project has the baseline
+// templates with the baseline rules. A failure in one vdc does not stop the
+// rollout to the others, so every result carries its own error.
+func (p *Project) ApplyFirewallBaseline(baseline []*FirewallBaselineTemplate) (results []*FirewallBaselineResult, err error) {
+	vdcs, err := p.GetVdcs()
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] get-vdc list for firewall baseline failed: %s", err)
+		return
+	}
+
+	failed := 0
+	for _, vdc := range vdcs {
+		for _, template := range baseline {
+			result := vdc.applyFirewallBaselineTemplate(template)
+			if result.Err != nil {
+				failed++
+			}
+			results = append(results, result)
+		}
+	}
+
+	if failed > 0 {
+		err = errors.Errorf("firewall baseline failed for %d of %d templates", failed, len(results))
+	}
+
+	return
+}
+
+func (v *Vdc) ApplyFirewallBaseline(baseline []*FirewallBaselineTemplate) (results []*FirewallBaselineResult, err error) {
+	failed := 0
+	for _, template := range baseline {
+		result := v.applyFirewallBaselineTemplate(template)
+		if result.Err != nil {
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	if failed > 0 {
+		err = errors.Errorf("firewall baseline failed for %d of %d templates in vdc '%s'", failed, len(results), v.ID)
+	}
+
+	return
+}
+
+func (v *Vdc) applyFirewallBaselineTemplate(baseline *FirewallBaselineTemplate) (result *FirewallBaselineResult) {
+	result = &FirewallBaselineResult{Vdc: v}
+
+	templates, err := v.GetFirewallTemplates()
+	if err != nil {
+		result.Err = errors.Wrapf(err, "get firewall templates of vdc '%s'", v.ID)
+		return
+	}
+
+	for _, template := range templates {
+		if template.Name == baseline.Name && template.Vdc != nil && template.Vdc.ID == v.ID {
+			result.Template = template
+			break
+		}
+	}
+
+	if result.Template == nil {
+		template := NewFirewallTemplate(baseline.Name)
+		template.Description = baseline.Description
+		if err = v.CreateFirewallTemplate(&template); err != nil {
+			result.Err = errors.Wrapf(err, "create firewall template '%s' in vdc '%s'", baseline.Name, v.ID)
+			return
+		}
+		result.Template = &template
+		result.Created = true
+	}
+
+	result.RulesCreated, result.RulesUpdated, result.RulesDeleted, err = result.Template.syncRules(baseline.Rules, baseline.Prune)
+	if err != nil {
+		result.Err = errors.Wrapf(err, "converge rules of firewall template '%s' in vdc '%s'", baseline.Name, v.ID)
+	}
+
+	return
+}
+
+func (f *FirewallTemplate) syncRules(rules []*FirewallRule, prune bool) (created int, updated int, deleted int, err error) {
+	existing, err := f.GetFirewallRules()
+	if err != nil {
+		return
+	}
+
+	byName := make(map[string]*FirewallRule, len(existing))
+	for _, rule := range existing {
+		if _, ok := byName[rule.Name]; ok {
+			err = errors.Errorf("firewall template '%s' has more than one rule named '%s'", f.ID, rule.Name)
+			return
+		}
+		byName[rule.Name] = rule
+	}
+
+	wanted := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		wanted[rule.Name] = true
+
+		current, ok := byName[rule.Name]
+		if !ok {
+			newRule := FirewallRule{
+				Name:            rule.Name,
+				DestinationIp:   rule.DestinationIp,
+				Direction:       rule.Direction,
+				DstPortRangeMax: rule.DstPortRangeMax,
+				DstPortRangeMin: rule.DstPortRangeMin,
+				Protocol:        rule.Protocol,
+			}
+			if err = f.CreateFirewallRule(&newRule); err != nil {
+				return
+			}
+			created++
+			continue
+		}
+
+		if current.sameAs(rule) {
+			continue
+		}
+
+		current.DestinationIp = rule.DestinationIp
+		current.Direction = rule.Direction
+		current.Protocol = rule.Protocol
+		current.DstPortRangeMax = rule.DstPortRangeMax
+		current.DstPortRangeMin = rule.DstPortRangeMin
+		if err = current.Update(); err != nil {
+			return
+		}
+		updated++
+	}
+
+	if !prune {
+		return
+	}
+
+	for _, rule := range existing {
+		if wanted[rule.Name] {
+			continue
+		}
+		if err = rule.Delete(); err != nil {
+			return
+		}
+		deleted++
+	}
+
+	return
+}
+
+func (f *FirewallRule) sameAs(other *FirewallRule) bool {
+	if f.Name != other.Name ||
+		f.DestinationIp != other.DestinationIp ||
+		f.Direction != other.Direction ||
+		f.Protocol != other.Protocol {
+		return false
+	}
+
+	// port ranges are only sent for tcp and udp rules
+	if f.Protocol != "tcp" && f.Protocol != "udp" {
+		return true
+	}
+
+	return equalIntPtr(f.DstPortRangeMax, other.DstPortRangeMax) &&
+		equalIntPtr(f.DstPortRangeMin, other.DstPortRangeMin)
+}
+
+func equalIntPtr(a *int, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
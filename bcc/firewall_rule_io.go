@@ -0,0 +1,197 @@
+package bcc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	FirewallRulesFormatJson = "json"
+	FirewallRulesFormatCsv  = "csv"
+)
+
+var firewallRulesCsvHeader = []string{"name", "direction", "protocol", "destination_ip", "dst_port_range_min", "dst_port_range_max"}
+
+type firewallRuleRecord struct {
+	Name            string `json:"name"`
+	Direction       string `json:"direction"`
+	Protocol        string `json:"protocol"`
+	DestinationIp   string `json:"destination_ip"`
+	DstPortRangeMin *int   `json:"dst_port_range_min,omitempty"`
+	DstPortRangeMax *int   `json:"dst_port_range_max,omitempty"`
+}
+
+// ExportRules writes the rules of the template to w as json or csv, in the
+// same format ParseFirewallRules reads.
+func (f *FirewallTemplate) ExportRules(w io.Writer, format string) error {
+	rules, err := f.GetFirewallRules()
+	if err != nil {
+		return err
+	}
+
+	records := make([]*firewallRuleRecord, len(rules))
+	for i, rule := range rules {
+		records[i] = &firewallRuleRecord{
+			Name:            rule.Name,
+			Direction:       rule.Direction,
+			Protocol:        rule.Protocol,
+			DestinationIp:   rule.DestinationIp,
+			DstPortRangeMin: rule.DstPortRangeMin,
+			DstPortRangeMax: rule.DstPortRangeMax,
+		}
+	}
+
+	switch format {
+	case FirewallRulesFormatJson:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case FirewallRulesFormatCsv:
+		writer := csv.NewWriter(w)
+		if err = writer.Write(firewallRulesCsvHeader); err != nil {
+			return err
+		}
+		for _, record := range records {
+			row := []string{record.Name, record.Direction, record.Protocol, record.DestinationIp, formatPort(record.DstPortRangeMin), formatPort(record.DstPortRangeMax)}
+			if err = writer.Write(row); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return errors.Errorf("unknown firewall rules format '%s'", format)
+	}
+}
+
+// ParseFirewallRules reads rules written by ExportRules.
+func ParseFirewallRules(r io.Reader, format string) (rules []*FirewallRule, err error) {
+	var records []*firewallRuleRecord
+
+	switch format {
+	case FirewallRulesFormatJson:
+		if err = json.NewDecoder(r).Decode(&records); err != nil {
+			return nil, errors.Wrap(err, "decode firewall rules")
+		}
+	case FirewallRulesFormatCsv:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = len(firewallRulesCsvHeader)
+		rows, err := reader.ReadAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "decode firewall rules")
+		}
+		for i, row := range rows {
+			if i == 0 && row[0] == firewallRulesCsvHeader[0] {
+				continue
+			}
+			record := &firewallRuleRecord{Name: row[0], Direction: row[1], Protocol: row[2], DestinationIp: row[3]}
+			if record.DstPortRangeMin, err = parsePort(row[4]); err != nil {
+				return nil, errors.Wrapf(err, "line %d", i+1)
+			}
+			if record.DstPortRangeMax, err = parsePort(row[5]); err != nil {
+				return nil, errors.Wrapf(err, "line %d", i+1)
+			}
+			records = append(records, record)
+		}
+	default:
+		return nil, errors.Errorf("unknown firewall rules format '%s'", format)
+	}
+
+	for _, record := range records {
+		rules = append(rules, &FirewallRule{
+			Name:            record.Name,
+			Direction:       record.Direction,
+			Protocol:        record.Protocol,
+			DestinationIp:   record.DestinationIp,
+			DstPortRangeMin: record.DstPortRangeMin,
+			DstPortRangeMax: record.DstPortRangeMax,
+		})
+	}
+
+	return
+}
+
+// FirewallRulesPartialError is returned by ImportRules when the platform
+// rejected a change after others were already applied. The counts tell what
+// the template was left with.
+type FirewallRulesPartialError struct {
+	Created int
+	Updated int
+	Deleted int
+	err     error
+}
+
+func (e *FirewallRulesPartialError) Error() string {
+	return fmt.Sprintf("firewall rules partially applied (%d created, %d updated, %d deleted): %s", e.Created, e.Updated, e.Deleted, e.err)
+}
+
+func (e *FirewallRulesPartialError) Unwrap() error { return e.err }
+
+// ImportRules makes the rules of the template match rules, which are matched
+// to existing ones by name. The whole set is validated before anything is
+// changed, so a malformed file leaves the template untouched. The changes
+// are not atomic though, when the platform rejects one of them the rules
+// applied so far are kept and a FirewallRulesPartialError is returned. With
+// prune, rules missing from the set are deleted.
+func (f *FirewallTemplate) ImportRules(rules []*FirewallRule, prune bool) (created int, updated int, deleted int, err error) {
+	if err = validateFirewallRules(rules); err != nil {
+		return
+	}
+
+	created, updated, deleted, err = f.syncRules(rules, prune)
+	if err != nil && created+updated+deleted > 0 {
+		err = &FirewallRulesPartialError{Created: created, Updated: updated, Deleted: deleted, err: err}
+	}
+
+	return
+}
+
+func validateFirewallRules(rules []*FirewallRule) error {
+	names := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return errors.Errorf("firewall rule %d has no name", i+1)
+		}
+		if names[rule.Name] {
+			return errors.Errorf("firewall rule '%s' is defined more than once", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.Direction != "ingress" && rule.Direction != "egress" {
+			return errors.Errorf("firewall rule '%s' has unknown direction '%s'", rule.Name, rule.Direction)
+		}
+		if rule.Protocol == "tcp" || rule.Protocol == "udp" {
+			if rule.DstPortRangeMin == nil || rule.DstPortRangeMax == nil {
+				return errors.Errorf("firewall rule '%s' needs a port range for %s", rule.Name, rule.Protocol)
+			}
+			if *rule.DstPortRangeMin > *rule.DstPortRangeMax {
+				return errors.Errorf("firewall rule '%s' has an empty port range", rule.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func formatPort(port *int) string {
+	if port == nil {
+		return ""
+	}
+	return strconv.Itoa(*port)
+}
+
+func parsePort(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port '%s'", value)
+	}
+	return &port, nil
+}
@@ -86,11 +86,21 @@ func (m *Manager) GetFirewallRules(id string, extraArgs ...Arguments) (firewallR
 
 	if err = m.Get(path, args, &firewallRules); err != nil {
 		log.Printf("[REQUEST-ERROR] get-Firewall rule list failed: %s", err)
+	} else {
+		for i := range firewallRules {
+			firewallRules[i].manager = m
+			firewallRules[i].TemplateId = id
+		}
 	}
 
 	return
 }
 
+func (f *FirewallTemplate) GetFirewallRules(extraArgs ...Arguments) (firewallRules []*FirewallRule, err error) {
+	firewallRules, err = f.manager.GetFirewallRules(f.ID, extraArgs...)
+	return
+}
+
 func (f *FirewallRule) Update() (err error) {
 	path := fmt.Sprintf("v1/firewall/%s/rule/%s", f.TemplateId, f.ID)
 
@@ -2,13 +2,19 @@ package bcc
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"net/url"
 )
 
 type Floating struct {
-	ID        string `json:"id"`
-	IpAddress string `json:"ip_address"`
+	manager   *Manager
+	ID        string     `json:"id"`
+	IpAddress string     `json:"ip_address"`
+	IpVersion int        `json:"ip_version,omitempty"`
+	Vdc       *Vdc       `json:"vdc,omitempty"`
+	Connected *Connected `json:"connected,omitempty"`
+	Locked    bool       `json:"locked,omitempty"`
 }
 
 func (m *Manager) GetFloating(id string) (fip *Floating, err error) {
@@ -16,20 +22,35 @@ func (m *Manager) GetFloating(id string) (fip *Floating, err error) {
 
 	if err = m.Get(path, Defaults(), &fip); err != nil {
 		log.Printf("[REQUEST-ERROR] get-floating with id='%s' failed: %s", id, err)
+	} else {
+		fip.manager = m
 	}
 
 	return
 }
 
-func (v *Vdc) GetFloatingByAddress(address string) (fip *Floating, err error) {
+func (v *Vdc) GetFloatings(extraArgs ...Arguments) (fips []*Floating, err error) {
 	path := "v1/port"
 	args := Arguments{
 		"vdc":         v.ID,
 		"filter_type": "external",
 	}
-	var items []*Floating
+	args.merge(extraArgs)
+
+	if err = v.manager.GetItems(path, args, &fips); err != nil {
+		log.Printf("[REQUEST-ERROR] get-floating list failed: %s", err)
+	} else {
+		for i := range fips {
+			fips[i].manager = v.manager
+		}
+	}
 
-	if err = v.manager.GetItems(path, args, &items); err != nil {
+	return
+}
+
+func (v *Vdc) GetFloatingByAddress(address string) (fip *Floating, err error) {
+	items, err := v.GetFloatings()
+	if err != nil {
 		log.Printf("[REQUEST-ERROR] get-floating by address '%s' failed: %s", address, err)
 	} else {
 		for i := 0; i < len(items); i++ {
@@ -42,3 +63,88 @@ func (v *Vdc) GetFloatingByAddress(address string) (fip *Floating, err error) {
 
 	return nil, errors.New("ERROR. Address not found")
 }
+
+// AllocateFloating reserves a free address of the public pool for the vdc.
+func (v *Vdc) AllocateFloating() (fip *Floating, err error) {
+	return v.AllocateFloatingOfVersion(0)
+}
+
+// AllocateFloatingOfVersion reserves a public address of the given ip
+// version, zero lets the platform pick the default family.
+func (v *Vdc) AllocateFloatingOfVersion(ipVersion int) (fip *Floating, err error) {
+	path := "v1/floating"
+	args := &struct {
+		Vdc       string `json:"vdc"`
+		IpVersion int    `json:"ip_version,omitempty"`
+	}{
+		Vdc:       v.ID,
+		IpVersion: ipVersion,
+	}
+
+	fip = &Floating{}
+	if err = v.manager.Request("POST", path, args, fip); err != nil {
+		log.Printf("[REQUEST-ERROR] allocate-floating failed: %s", err)
+	} else {
+		fip.manager = v.manager
+	}
+
+	return
+}
+
+// Attach binds the address to a *Port, *Vm or *Router.
+func (f *Floating) Attach(target interface{}) (err error) {
+	path := fmt.Sprintf("v1/floating/%s/attach", f.ID)
+	args := &struct {
+		Port   string `json:"port,omitempty"`
+		Vm     string `json:"vm,omitempty"`
+		Router string `json:"router,omitempty"`
+	}{}
+
+	switch t := target.(type) {
+	case *Port:
+		args.Port = t.ID
+	case *Vm:
+		args.Vm = t.ID
+	case *Router:
+		args.Router = t.ID
+	default:
+		return fmt.Errorf("ERROR. Unknown type: %s", t)
+	}
+
+	if err = f.manager.Request("POST", path, args, f); err != nil {
+		log.Printf("[REQUEST-ERROR] attach-floating with id='%s' failed: %s", f.ID, err)
+	}
+
+	return
+}
+
+func (f *Floating) Detach() (err error) {
+	path := fmt.Sprintf("v1/floating/%s/detach", f.ID)
+
+	if err = f.manager.Request("POST", path, nil, f); err != nil {
+		log.Printf("[REQUEST-ERROR] detach-floating with id='%s' failed: %s", f.ID, err)
+	}
+
+	return
+}
+
+// Release returns the address to the public pool.
+func (f *Floating) Release() (err error) {
+	path, _ := url.JoinPath("v1/floating", f.ID)
+
+	if err = f.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] release-floating with id='%s' failed: %s", f.ID, err)
+	}
+
+	return
+}
+
+func (f Floating) WaitLock() (err error) {
+	path, _ := url.JoinPath("v1/floating", f.ID)
+
+	if err = loopWaitLock(f.manager, path); err != nil {
+		log.Printf("[REQUEST-ERROR] wait-lock for floating with id='%s' failed: %s", f.ID, err)
+	}
+
+	return
+}
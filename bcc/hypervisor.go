@@ -19,9 +19,7 @@ type Hypervisor struct {
 func (p *Project) GetAvailableHypervisors(extraArgs ...Arguments) (hypervisors []*Hypervisor, err error) {
 	path, _ := url.JoinPath("v1/project", p.ID)
 	type tempType struct {
-		Client struct {
-			AllowedHypervisors []*Hypervisor `json:"allowed_hypervisors"`
-		} `json:"client"`
+		Client Client `json:"client"`
 	}
 
 	var target tempType
@@ -31,11 +29,8 @@ func (p *Project) GetAvailableHypervisors(extraArgs ...Arguments) (hypervisors [
 	if err = p.manager.Get(path, args, &target); err != nil {
 		log.Printf("[REQUEST-ERROR] get-projects for hypervisor failed: %s", err)
 	} else {
+		target.Client.setManager(p.manager)
 		hypervisors = target.Client.AllowedHypervisors
-
-		for i := range hypervisors {
-			hypervisors[i].manager = p.manager
-		}
 	}
 
 	return
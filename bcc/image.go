@@ -0,0 +1,206 @@
+package bcc
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ImageFormatQcow2 = "qcow2"
+	ImageFormatVmdk  = "vmdk"
+
+	ImageStatusUploading  = "uploading"
+	ImageStatusConverting = "converting"
+	ImageStatusReady      = "ready"
+	ImageStatusError      = "error"
+)
+
+// Image is a customer provided disk image which is registered as a template
+// once it has been converted.
+type Image struct {
+	manager  *Manager
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Format   string    `json:"format"`
+	OsFamily string    `json:"os_family"`
+	Status   string    `json:"status"`
+	Size     int64     `json:"size"`
+	Vdc      *MetaData `json:"vdc"`
+	Template *Template `json:"template,omitempty"`
+	Locked   bool      `json:"locked"`
+}
+
+func NewImage(name string, format string, osFamily string) Image {
+	i := Image{Name: name, Format: format, OsFamily: osFamily}
+	return i
+}
+
+func (m *Manager) GetImages(extraArgs ...Arguments) (images []*Image, err error) {
+	path := "v1/image"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &images); err != nil {
+		log.Printf("[REQUEST-ERROR] get-image list failed: %s", err)
+	} else {
+		for i := range images {
+			images[i].setManager(m)
+		}
+	}
+
+	return
+}
+
+func (v *Vdc) GetImages(extraArgs ...Arguments) (images []*Image, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	images, err = v.manager.GetImages(args)
+	return
+}
+
+// UploadImage registers the image in the vdc and uploads it in chunks. The
+// call returns once the platform has converted the image, Template is set
+// afterwards. An interrupted upload is resumed with Image.Upload.
+func (v *Vdc) UploadImage(image *Image, r io.ReaderAt, size int64, opts UploadOptions) (err error) {
+	args := &struct {
+		Name     string `json:"name"`
+		Vdc      string `json:"vdc"`
+		Format   string `json:"format"`
+		OsFamily string `json:"os_family"`
+		Size     int64  `json:"size"`
+	}{
+		Name:     image.Name,
+		Vdc:      v.ID,
+		Format:   image.Format,
+		OsFamily: image.OsFamily,
+		Size:     size,
+	}
+
+	if err = v.manager.Request("POST", "v1/image", args, &image); err != nil {
+		log.Printf("[REQUEST-ERROR] create-image failed: %s", err)
+		return
+	}
+
+	image.setManager(v.manager)
+	if v.manager.DryRun {
+		return
+	}
+
+	return image.Upload(r, size, opts)
+}
+
+// RegisterImageFromURL lets the platform download the image from imageUrl.
+// Like UploadImage the call returns once the image has been converted and
+// Template is set.
+func (v *Vdc) RegisterImageFromURL(image *Image, imageUrl string) (err error) {
+	args := &struct {
+		Name     string `json:"name"`
+		Vdc      string `json:"vdc"`
+		Format   string `json:"format"`
+		OsFamily string `json:"os_family"`
+		Url      string `json:"url"`
+	}{
+		Name:     image.Name,
+		Vdc:      v.ID,
+		Format:   image.Format,
+		OsFamily: image.OsFamily,
+		Url:      imageUrl,
+	}
+
+	if err = v.manager.Request("POST", "v1/image", args, &image); err != nil {
+		log.Printf("[REQUEST-ERROR] register-image from url failed: %s", err)
+		return
+	}
+
+	image.setManager(v.manager)
+	if v.manager.DryRun {
+		return
+	}
+
+	return image.WaitReady()
+}
+
+func (i *Image) Upload(r io.ReaderAt, size int64, opts UploadOptions) (err error) {
+	path := fmt.Sprintf("v1/image/%s/upload", i.ID)
+
+	if err = i.manager.UploadChunked(path, r, size, opts, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] upload-image with id='%s' failed: %s", i.ID, err)
+		return
+	}
+
+	return i.WaitReady()
+}
+
+// WaitReady polls the image until the platform has converted it and
+// registered the template, an image in error status is returned as an
+// error.
+func (i *Image) WaitReady() error {
+	for {
+		if err := i.Reload(); err != nil {
+			return err
+		}
+		switch i.Status {
+		case ImageStatusReady:
+			return nil
+		case ImageStatusError:
+			return errors.Errorf("image '%s' failed to convert", i.ID)
+		}
+
+		if err := i.manager.sleep(RetryTime * time.Millisecond); err != nil {
+			return err
+		}
+	}
+}
+
+// GetTemplate returns the template the image was registered as.
+func (i *Image) GetTemplate() (*Template, error) {
+	if err := i.Reload(); err != nil {
+		return nil, err
+	}
+
+	if i.Template == nil {
+		return nil, errors.Errorf("image '%s' is not registered as a template yet, status '%s'", i.ID, i.Status)
+	}
+
+	return i.Template, nil
+}
+
+func (i *Image) Reload() (err error) {
+	path, _ := url.JoinPath("v1/image", i.ID)
+	m := i.manager
+
+	if err = m.Get(path, Defaults(), &i); err != nil {
+		log.Printf("[REQUEST-ERROR] get-image with id='%s' failed: %s", i.ID, err)
+	} else {
+		i.setManager(m)
+	}
+
+	return
+}
+
+func (i *Image) setManager(m *Manager) {
+	i.manager = m
+	if i.Template != nil {
+		i.Template.manager = m
+	}
+}
+
+func (i *Image) Delete() (err error) {
+	path, _ := url.JoinPath("v1/image", i.ID)
+	if err = i.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-image failed: %s", err)
+	}
+	return
+}
+
+func (i Image) WaitLock() (err error) {
+	path, _ := url.JoinPath("v1/image", i.ID)
+	return loopWaitLock(i.manager, path)
+}
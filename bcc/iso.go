@@ -0,0 +1,168 @@
+package bcc
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+)
+
+const (
+	IsoStatusUploading = "uploading"
+	IsoStatusReady     = "ready"
+	IsoStatusError     = "error"
+)
+
+type Iso struct {
+	manager *Manager
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	Status  string    `json:"status"`
+	Vdc     *MetaData `json:"vdc"`
+	Locked  bool      `json:"locked"`
+}
+
+func NewIso(name string) Iso {
+	i := Iso{Name: name}
+	return i
+}
+
+func (m *Manager) GetIsos(extraArgs ...Arguments) (isos []*Iso, err error) {
+	path := "v1/iso"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &isos); err != nil {
+		log.Printf("[REQUEST-ERROR] get-iso list failed: %s", err)
+	} else {
+		for i := range isos {
+			isos[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (v *Vdc) GetIsos(extraArgs ...Arguments) (isos []*Iso, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	isos, err = v.manager.GetIsos(args)
+	return
+}
+
+func (m *Manager) GetIso(id string) (iso *Iso, err error) {
+	path, _ := url.JoinPath("v1/iso", id)
+
+	if err = m.Get(path, Defaults(), &iso); err != nil {
+		log.Printf("[REQUEST-ERROR] get-iso with id='%s' failed: %s", id, err)
+	} else {
+		iso.manager = m
+	}
+
+	return
+}
+
+// UploadIso registers the iso in the vdc and uploads the image of the given
+// size in chunks. When the upload is interrupted the iso is kept, pass the
+// Offset of the returned UploadError to Iso.Upload to resume it.
+func (v *Vdc) UploadIso(iso *Iso, r io.ReaderAt, size int64, opts UploadOptions) (err error) {
+	path := "v1/iso"
+	args := &struct {
+		Name string `json:"name"`
+		Vdc  string `json:"vdc"`
+		Size int64  `json:"size"`
+	}{
+		Name: iso.Name,
+		Vdc:  v.ID,
+		Size: size,
+	}
+
+	if err = v.manager.Request("POST", path, args, &iso); err != nil {
+		log.Printf("[REQUEST-ERROR] create-iso failed: %s", err)
+		return
+	}
+
+	iso.manager = v.manager
+	if v.manager.DryRun {
+		return
+	}
+
+	return iso.Upload(r, size, opts)
+}
+
+// CreateIsoFromURL lets the platform download the image from isoUrl.
+func (v *Vdc) CreateIsoFromURL(iso *Iso, isoUrl string) (err error) {
+	path := "v1/iso"
+	args := &struct {
+		Name string `json:"name"`
+		Vdc  string `json:"vdc"`
+		Url  string `json:"url"`
+	}{
+		Name: iso.Name,
+		Vdc:  v.ID,
+		Url:  isoUrl,
+	}
+
+	if err = v.manager.Request("POST", path, args, &iso); err != nil {
+		log.Printf("[REQUEST-ERROR] create-iso from url failed: %s", err)
+		return
+	}
+
+	iso.manager = v.manager
+	if v.manager.DryRun {
+		return
+	}
+
+	return iso.WaitLock()
+}
+
+func (i *Iso) Upload(r io.ReaderAt, size int64, opts UploadOptions) (err error) {
+	path := fmt.Sprintf("v1/iso/%s/upload", i.ID)
+
+	if err = i.manager.UploadChunked(path, r, size, opts, i); err != nil {
+		log.Printf("[REQUEST-ERROR] upload-iso with id='%s' failed: %s", i.ID, err)
+	}
+
+	return
+}
+
+func (i *Iso) Delete() (err error) {
+	path, _ := url.JoinPath("v1/iso", i.ID)
+	if err = i.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-iso failed: %s", err)
+	}
+	return
+}
+
+func (i Iso) WaitLock() (err error) {
+	path, _ := url.JoinPath("v1/iso", i.ID)
+	return loopWaitLock(i.manager, path)
+}
+
+// InsertIso attaches the iso to the virtual cd-rom of the vm, combine it
+// with BootFromCdrom to install an operating system.
+func (v *Vm) InsertIso(iso *Iso) (err error) {
+	path := fmt.Sprintf("v1/vm/%s/cdrom", v.ID)
+	args := &struct {
+		Iso string `json:"iso"`
+	}{
+		Iso: iso.ID,
+	}
+
+	if err = v.manager.Request("POST", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] insert-iso into vm with id='%s' failed: %s", v.ID, err)
+	}
+
+	return
+}
+
+func (v *Vm) EjectIso() (err error) {
+	path := fmt.Sprintf("v1/vm/%s/cdrom", v.ID)
+	if err = v.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] eject-iso from vm with id='%s' failed: %s", v.ID, err)
+	}
+	return
+}
@@ -1,7 +1,9 @@
 package bcc
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"log"
 	"net/url"
 )
@@ -23,6 +25,8 @@ type Kubernetes struct {
 	Floating      *Port               `json:"floating"`
 	UserPublicKey string              `json:"user_public_key"`
 	Template      *KubernetesTemplate `json:"template"`
+	Version       string              `json:"version,omitempty"`
+	Network       *Network            `json:"network,omitempty"`
 
 	NodeCpu            int             `json:"node_cpu"`
 	NodeRam            int             `json:"node_ram"`
@@ -38,6 +42,7 @@ type Kubernetes struct {
 
 type KubernetesDashBoardUrl struct {
 	DashBoardUrl *string `json:"url"`
+	Token        *string `json:"token,omitempty"`
 }
 
 func NewKubernetes(name string, nodeCpu int, nodeRam int, nodesCount int, nodeDiskSize int, floating *string, template *KubernetesTemplate, nodeStorageProfile *StorageProfile, userPublicKey string, nodePlatform *Platform) Kubernetes {
@@ -57,45 +62,106 @@ func (m *Manager) ListKubernetes(extraArgs ...Arguments) (k8s []*Kubernetes, err
 		log.Printf("[REQUEST-ERROR] get-kubernetes list failed: %s", err)
 	} else {
 		for i := range k8s {
-			k8s[i].manager = m
-			for x := range k8s[i].Vms {
-				k8s[i].Vms[x].manager = m
-			}
+			k8s[i].setManager(m)
 		}
 	}
 
 	return
 }
 
-func (k *Kubernetes) GetKubernetesConfigUrl() (err error) {
-	path := fmt.Sprintf("/v1/kubernetes/%s/config", k.ID)
-	var config *string
+func (m *Manager) GetKubeConfig(clusterID string) ([]byte, error) {
+	var config bytes.Buffer
+
+	if err := m.WriteKubeConfig(clusterID, &config); err != nil {
+		return nil, err
+	}
+
+	return config.Bytes(), nil
+}
+
+func (m *Manager) WriteKubeConfig(clusterID string, w io.Writer) (err error) {
+	path := fmt.Sprintf("/v1/kubernetes/%s/config", clusterID)
 
-	if err = k.manager.Get(path, Defaults(), &config); err != nil {
+	if err = m.Download(path, w); err != nil {
 		log.Printf("[REQUEST-ERROR] get-kubernetes-config failed: %s", err)
 	}
 
 	return
 }
 
+func (k *Kubernetes) GetKubeConfig() ([]byte, error) {
+	return k.manager.GetKubeConfig(k.ID)
+}
+
+func (k *Kubernetes) WriteKubeConfig(w io.Writer) error {
+	return k.manager.WriteKubeConfig(k.ID, w)
+}
+
+// RotateConfig regenerates the cluster credentials, so kubeconfigs handed
+// out before stop working, and returns the new kubeconfig.
+func (k *Kubernetes) RotateConfig() ([]byte, error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/config/rotate", k.ID)
+
+	if err := k.manager.Request("POST", path, nil, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] rotate-kubernetes-config failed: %s", err)
+		return nil, err
+	}
+
+	return k.GetKubeConfig()
+}
+
+// Deprecated: GetKubernetesConfigUrl saves kubectl-<id>.yaml into the current
+// working directory, use GetKubeConfig or WriteKubeConfig instead.
+func (k *Kubernetes) GetKubernetesConfigUrl() (err error) {
+	config, err := k.GetKubeConfig()
+	if err != nil {
+		return
+	}
+
+	requestUrl, _ := url.JoinPath(k.manager.BaseURL, "/v1/kubernetes", k.ID, "config")
+	if err = CreateKubeCtlConfigFile(config, requestUrl, k.manager.BaseURL+KubeCtlConfigURL); err != nil {
+		log.Printf("[REQUEST-ERROR] save-kubernetes-config failed: %s", err)
+	}
+
+	return
+}
+
 func (k *Kubernetes) GetKubernetesDashBoardUrl() (dashboardUrl *KubernetesDashBoardUrl, err error) {
 	path := fmt.Sprintf("/v1/kubernetes/%s/dashboard", k.ID)
 
-	if err = k.manager.Get(path, Defaults(), &dashboardUrl); err != nil {
+	dashboardUrl = &KubernetesDashBoardUrl{}
+	if err = k.manager.Get(path, Defaults(), dashboardUrl); err != nil {
 		log.Printf("[REQUEST-ERROR] get-kubernetes-dashboard failed: %s", err)
 	}
 
 	return
 }
 
+// GetDashboardURL returns the hosted dashboard endpoint of the cluster and
+// the token to log in with.
+func (k *Kubernetes) GetDashboardURL() (dashboardUrl string, token string, err error) {
+	dashboard, err := k.GetKubernetesDashBoardUrl()
+	if err != nil {
+		return
+	}
+
+	if dashboard.DashBoardUrl != nil {
+		dashboardUrl = *dashboard.DashBoardUrl
+	}
+	if dashboard.Token != nil {
+		token = *dashboard.Token
+	}
+
+	return
+}
+
 func (m *Manager) GetKubernetes(id string) (k8s *Kubernetes, err error) {
 	path, _ := url.JoinPath("/v1/kubernetes", id)
 
 	if err = m.Get(path, Defaults(), &k8s); err != nil {
 		log.Printf("[REQUEST-ERROR] get-kubernetes failed: %s", err)
 	} else {
-		k8s.Vdc.manager = m
-		k8s.manager = m
+		k8s.setManager(m)
 	}
 
 	return
@@ -128,6 +194,8 @@ func (v *Vdc) CreateKubernetes(k8s *Kubernetes) (err error) {
 		Floating           *string  `json:"floating"`
 		UserPublicKey      string   `json:"user_public_key"`
 		NodePlatform       *string  `json:"node_platform,omitempty"`
+		Network            *string  `json:"network,omitempty"`
+		Version            string   `json:"version,omitempty"`
 		Tags               []string `json:"tags"`
 	}{
 		Name:               k8s.Name,
@@ -141,11 +209,20 @@ func (v *Vdc) CreateKubernetes(k8s *Kubernetes) (err error) {
 		UserPublicKey:      k8s.UserPublicKey,
 		Floating:           nil,
 		NodePlatform:       nil,
+		Version:            k8s.Version,
 		Tags:               convertTagsToNames(k8s.Tags),
 	}
 
 	if k8s.Floating != nil {
-		args.Floating = k8s.Floating.IpAddress
+		if k8s.Floating.ID != "" {
+			args.Floating = &k8s.Floating.ID
+		} else {
+			args.Floating = k8s.Floating.IpAddress
+		}
+	}
+
+	if k8s.Network != nil {
+		args.Network = &k8s.Network.ID
 	}
 
 	if k8s.NodePlatform != nil {
@@ -154,14 +231,20 @@ func (v *Vdc) CreateKubernetes(k8s *Kubernetes) (err error) {
 
 	if err = v.manager.Request("POST", path, args, &k8s); err != nil {
 		log.Printf("[REQUEST-ERROR] create-kubernetes failed: %s", err)
-	} else {
-		k8s.manager = v.manager
-		for idx := range k8s.Vms {
-			k8s.Vms[idx].manager = v.manager
-		}
+		return
 	}
 
-	return
+	k8s.setManager(v.manager)
+	if v.manager.DryRun {
+		return
+	}
+
+	if err = k8s.WaitLock(); err != nil {
+		log.Printf("[REQUEST-ERROR] wait-lock for kubernetes '%s' failed: %s", k8s.ID, err)
+		return
+	}
+
+	return k8s.Reload()
 }
 
 func (k *Kubernetes) Update() (err error) {
@@ -196,13 +279,45 @@ func (k *Kubernetes) Update() (err error) {
 		}
 	}
 
-	if err = k.manager.Request("PUT", path, args, k); err != nil {
+	m := k.manager
+	if err = m.Request("PUT", path, args, k); err != nil {
 		log.Printf("[REQUEST-ERROR] update-kubernetes failed: %s", err)
+		return
+	}
+
+	k.setManager(m)
+	return k.WaitLock()
+}
+
+func (k *Kubernetes) Reload() (err error) {
+	path, _ := url.JoinPath("/v1/kubernetes", k.ID)
+	m := k.manager
+
+	if err = m.Get(path, Defaults(), &k); err != nil {
+		log.Printf("[REQUEST-ERROR] get-kubernetes failed: %s", err)
+	} else {
+		k.setManager(m)
 	}
 
 	return
 }
 
+func (k *Kubernetes) setManager(m *Manager) {
+	k.manager = m
+	for _, vm := range k.Vms {
+		vm.manager = m
+	}
+	if k.Vdc != nil {
+		k.Vdc.manager = m
+	}
+	if k.Floating != nil {
+		k.Floating.manager = m
+	}
+	if k.Network != nil {
+		k.Network.manager = m
+	}
+}
+
 func (k *Kubernetes) Delete() (err error) {
 	path, _ := url.JoinPath("v1/kubernetes", k.ID)
 	if err = k.manager.Delete(path, Defaults(), nil); err != nil {
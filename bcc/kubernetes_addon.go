@@ -0,0 +1,86 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+const (
+	KubernetesAddonIngress    = "ingress"
+	KubernetesAddonMonitoring = "monitoring"
+	KubernetesAddonDashboard  = "dashboard"
+)
+
+type KubernetesAddon struct {
+	manager      *Manager
+	kubernetesId string
+	Name         string            `json:"name"`
+	Enabled      bool              `json:"enabled"`
+	Version      string            `json:"version,omitempty"`
+	Settings     map[string]string `json:"settings,omitempty"`
+	Locked       bool              `json:"locked"`
+}
+
+func (k *Kubernetes) GetAddons() (addons []*KubernetesAddon, err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/addon", k.ID)
+
+	if err = k.manager.Get(path, Defaults(), &addons); err != nil {
+		log.Printf("[REQUEST-ERROR] get-kubernetes-addon list failed: %s", err)
+	} else {
+		for i := range addons {
+			addons[i].manager = k.manager
+			addons[i].kubernetesId = k.ID
+		}
+	}
+
+	return
+}
+
+func (k *Kubernetes) GetAddon(name string) (addon *KubernetesAddon, err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/addon/%s", k.ID, name)
+
+	if err = k.manager.Get(path, Defaults(), &addon); err != nil {
+		log.Printf("[REQUEST-ERROR] get-kubernetes-addon '%s' failed: %s", name, err)
+	} else {
+		addon.manager = k.manager
+		addon.kubernetesId = k.ID
+	}
+
+	return
+}
+
+func (k *Kubernetes) EnableAddon(name string, settings map[string]string) (addon *KubernetesAddon, err error) {
+	addon = &KubernetesAddon{manager: k.manager, kubernetesId: k.ID, Name: name, Enabled: true, Settings: settings}
+	err = addon.Update()
+	return
+}
+
+func (k *Kubernetes) DisableAddon(name string) (err error) {
+	addon := &KubernetesAddon{manager: k.manager, kubernetesId: k.ID, Name: name, Enabled: false}
+	return addon.Update()
+}
+
+// Update applies the enabled flag and settings of the add-on and waits
+// until the cluster has rolled them out.
+func (a *KubernetesAddon) Update() (err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/addon/%s", a.kubernetesId, a.Name)
+	args := &struct {
+		Enabled  bool              `json:"enabled"`
+		Settings map[string]string `json:"settings,omitempty"`
+	}{
+		Enabled:  a.Enabled,
+		Settings: a.Settings,
+	}
+
+	if err = a.manager.Request("PUT", path, args, a); err != nil {
+		log.Printf("[REQUEST-ERROR] update-kubernetes-addon '%s' failed: %s", a.Name, err)
+		return
+	}
+
+	return a.WaitLock()
+}
+
+func (a KubernetesAddon) WaitLock() error {
+	path := fmt.Sprintf("v1/kubernetes/%s/addon/%s", a.kubernetesId, a.Name)
+	return loopWaitLock(a.manager, path)
+}
@@ -0,0 +1,66 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+const (
+	KubernetesNodeRoleMaster = "master"
+	KubernetesNodeRoleWorker = "worker"
+
+	KubernetesNodeStatusReady    = "Ready"
+	KubernetesNodeStatusNotReady = "NotReady"
+)
+
+type KubernetesNode struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Status    string    `json:"status"`
+	IpAddress string    `json:"ip_address"`
+	Vm        *TmpVm    `json:"vm"`
+	NodePool  *MetaData `json:"node_pool,omitempty"`
+}
+
+func (k *Kubernetes) GetNodes(extraArgs ...Arguments) (nodes []*KubernetesNode, err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node", k.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = k.manager.GetItems(path, args, &nodes); err != nil {
+		log.Printf("[REQUEST-ERROR] get-kubernetes-node list failed: %s", err)
+	} else {
+		for i := range nodes {
+			if nodes[i].Vm != nil {
+				nodes[i].Vm.manager = k.manager
+			}
+		}
+	}
+
+	return
+}
+
+// RemoveNode drains the worker and deletes it, the cluster shrinks by one.
+func (k *Kubernetes) RemoveNode(node *KubernetesNode) (err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node/%s", k.ID, node.ID)
+
+	if err = k.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] remove-kubernetes-node failed: %s", err)
+		return
+	}
+
+	return k.WaitLock()
+}
+
+// ReplaceNode drains the worker and swaps it for a freshly created one.
+func (k *Kubernetes) ReplaceNode(node *KubernetesNode) (err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node/%s/replace", k.ID, node.ID)
+
+	if err = k.manager.Request("POST", path, nil, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] replace-kubernetes-node failed: %s", err)
+		return
+	}
+
+	return k.WaitLock()
+}
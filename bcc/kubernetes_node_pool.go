@@ -0,0 +1,116 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type KubernetesNodePool struct {
+	manager            *Manager
+	kubernetesId       string
+	ID                 string          `json:"id"`
+	Name               string          `json:"name"`
+	NodeCpu            int             `json:"node_cpu"`
+	NodeRam            int             `json:"node_ram"`
+	NodeDiskSize       int             `json:"node_disk_size"`
+	NodesCount         int             `json:"nodes_count"`
+	NodeStorageProfile *StorageProfile `json:"node_storage_profile"`
+	NodePlatform       *Platform       `json:"node_platform,omitempty"`
+	Locked             bool            `json:"locked"`
+}
+
+func NewKubernetesNodePool(name string, nodeCpu int, nodeRam int, nodeDiskSize int, nodesCount int, nodeStorageProfile *StorageProfile, nodePlatform *Platform) KubernetesNodePool {
+	p := KubernetesNodePool{Name: name, NodeCpu: nodeCpu, NodeRam: nodeRam, NodeDiskSize: nodeDiskSize, NodesCount: nodesCount, NodeStorageProfile: nodeStorageProfile, NodePlatform: nodePlatform}
+	return p
+}
+
+// ScaleNodes changes the number of nodes of the default node pool.
+func (k *Kubernetes) ScaleNodes(count int) error {
+	k.NodesCount = count
+	return k.Update()
+}
+
+func (k *Kubernetes) GetNodePools(extraArgs ...Arguments) (pools []*KubernetesNodePool, err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node_pool", k.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = k.manager.GetItems(path, args, &pools); err != nil {
+		log.Printf("[REQUEST-ERROR] get-kubernetes-node-pool list failed: %s", err)
+	} else {
+		for i := range pools {
+			pools[i].manager = k.manager
+			pools[i].kubernetesId = k.ID
+		}
+	}
+
+	return
+}
+
+// CreateNodePool adds a pool of workers which may use a different flavor
+// than the default nodes of the cluster.
+func (k *Kubernetes) CreateNodePool(pool *KubernetesNodePool) (err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node_pool", k.ID)
+	args := &struct {
+		Name               string  `json:"name"`
+		NodeCpu            int     `json:"node_cpu"`
+		NodeRam            int     `json:"node_ram"`
+		NodeDiskSize       int     `json:"node_disk_size"`
+		NodesCount         int     `json:"nodes_count"`
+		NodeStorageProfile string  `json:"node_storage_profile"`
+		NodePlatform       *string `json:"node_platform,omitempty"`
+	}{
+		Name:               pool.Name,
+		NodeCpu:            pool.NodeCpu,
+		NodeRam:            pool.NodeRam,
+		NodeDiskSize:       pool.NodeDiskSize,
+		NodesCount:         pool.NodesCount,
+		NodeStorageProfile: pool.NodeStorageProfile.ID,
+	}
+
+	if pool.NodePlatform != nil {
+		args.NodePlatform = &pool.NodePlatform.ID
+	}
+
+	if err = k.manager.Request("POST", path, args, &pool); err != nil {
+		log.Printf("[REQUEST-ERROR] create-kubernetes-node-pool failed: %s", err)
+		return
+	}
+
+	pool.manager = k.manager
+	pool.kubernetesId = k.ID
+	if k.manager.DryRun {
+		return
+	}
+
+	return pool.WaitLock()
+}
+
+func (p *KubernetesNodePool) Resize(count int) (err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node_pool/%s", p.kubernetesId, p.ID)
+	args := &struct {
+		NodesCount int `json:"nodes_count"`
+	}{
+		NodesCount: count,
+	}
+
+	if err = p.manager.Patch(path, args, p); err != nil {
+		log.Printf("[REQUEST-ERROR] resize-kubernetes-node-pool failed: %s", err)
+		return
+	}
+
+	return p.WaitLock()
+}
+
+func (p *KubernetesNodePool) Delete() (err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/node_pool/%s", p.kubernetesId, p.ID)
+	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-kubernetes-node-pool failed: %s", err)
+	}
+	return
+}
+
+func (p KubernetesNodePool) WaitLock() error {
+	path := fmt.Sprintf("v1/kubernetes/%s/node_pool/%s", p.kubernetesId, p.ID)
+	return loopWaitLock(p.manager, path)
+}
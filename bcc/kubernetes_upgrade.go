@@ -0,0 +1,43 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+// GetAvailableVersions returns the kubernetes templates the cluster can be
+// upgraded to, every template is one kubernetes version.
+func (k *Kubernetes) GetAvailableVersions() (versions []*KubernetesTemplate, err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/upgrade", k.ID)
+
+	if err = k.manager.Get(path, Defaults(), &versions); err != nil {
+		log.Printf("[REQUEST-ERROR] get-kubernetes-versions failed: %s", err)
+	} else {
+		for i := range versions {
+			versions[i].manager = k.manager
+		}
+	}
+
+	return
+}
+
+// Upgrade starts a rolling upgrade of the cluster to version and returns a
+// handle to follow it, the nodes are replaced one by one. Reload the cluster
+// once the handle is done to see the new Template.
+func (k *Kubernetes) Upgrade(version *KubernetesTemplate) (handle *TaskHandle, err error) {
+	path := fmt.Sprintf("v1/kubernetes/%s/upgrade", k.ID)
+	args := &struct {
+		Template string `json:"template"`
+	}{
+		Template: version.ID,
+	}
+
+	taskIds, err := k.manager.requestTasks("POST", path, args, nil)
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] upgrade-kubernetes failed: %s", err)
+		return
+	}
+
+	handle = newTaskHandle(k.manager, taskIds)
+	return
+}
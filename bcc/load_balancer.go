@@ -30,6 +30,8 @@ type LoadBalancerPool struct {
 	Protocol           string        `json:"protocol,omitempty"`
 	SessionPersistence string        `json:"session_persistence,omitempty"`
 
+	HealthMonitor *LoadBalancerHealthMonitor `json:"health_monitor,omitempty"`
+
 	manager *Manager
 	Locked  bool `json:"locked"`
 }
@@ -39,6 +41,23 @@ type PoolMember struct {
 	Port   int    `json:"port"`
 	Weight int    `json:"weight"`
 	Vm     *TmpVm `json:"vm"`
+	// IpAddress is used for backends which are not vms of the vdc.
+	IpAddress *string `json:"ip_address,omitempty"`
+}
+
+const (
+	HealthMonitorTypeHttp = "HTTP"
+	HealthMonitorTypeTcp  = "TCP"
+	HealthMonitorTypePing = "PING"
+)
+
+type LoadBalancerHealthMonitor struct {
+	Type          string `json:"type"`
+	Delay         int    `json:"delay"`
+	Timeout       int    `json:"timeout"`
+	MaxRetries    int    `json:"max_retries"`
+	UrlPath       string `json:"url_path,omitempty"`
+	ExpectedCodes string `json:"expected_codes,omitempty"`
 }
 
 func NewLoadBalancer(name string, vdc *Vdc, port *Port, floating *Port) LoadBalancer {
@@ -84,6 +103,20 @@ func NewLoadBalancerPoolMember(port int, weight int, vm *TmpVm) PoolMember {
 	return member
 }
 
+func NewLoadBalancerPoolIpMember(port int, weight int, ipAddress string) PoolMember {
+	member := PoolMember{
+		Weight:    weight,
+		IpAddress: &ipAddress,
+		Port:      port,
+	}
+	return member
+}
+
+func NewLoadBalancerHealthMonitor(monitorType string, delay int, timeout int, maxRetries int) LoadBalancerHealthMonitor {
+	h := LoadBalancerHealthMonitor{Type: monitorType, Delay: delay, Timeout: timeout, MaxRetries: maxRetries}
+	return h
+}
+
 func (m *Manager) GetLoadBalancers(extraArgs ...Arguments) (lbaasList []*LoadBalancer, err error) {
 	path := "v1/lbaas"
 	args := Defaults()
@@ -93,12 +126,7 @@ func (m *Manager) GetLoadBalancers(extraArgs ...Arguments) (lbaasList []*LoadBal
 		log.Printf("[REQUEST-ERROR]: get-lbaas list failed: %s", err)
 	} else {
 		for i := range lbaasList {
-			lbaasList[i].manager = m
-			lbaasList[i].Port.manager = m
-			lbaasList[i].Vdc.manager = m
-			if lbaasList[i].Floating != nil {
-				lbaasList[i].Floating.manager = m
-			}
+			lbaasList[i].setManager(m)
 		}
 	}
 
@@ -120,12 +148,7 @@ func (m *Manager) GetLoadBalancer(id string) (lbaas *LoadBalancer, err error) {
 	if err = m.Get(path, Defaults(), &lbaas); err != nil {
 		log.Printf("[REQUEST-ERROR]: get-lbaas failed: %s", err)
 	} else {
-		lbaas.manager = m
-		lbaas.Port.manager = m
-		lbaas.Vdc.manager = m
-		if lbaas.Floating != nil {
-			lbaas.Floating.manager = m
-		}
+		lbaas.setManager(m)
 	}
 
 	return
@@ -177,7 +200,7 @@ func (lb *LoadBalancer) Create() (err error) {
 	return
 }
 
-func (v Vdc) CreateLoadBalancer(lb *LoadBalancer) (err error) {
+func (v *Vdc) CreateLoadBalancer(lb *LoadBalancer) (err error) {
 	path := "v1/lbaas"
 	type customPort struct {
 		ID                string     `json:"id"`
@@ -197,7 +220,7 @@ func (v Vdc) CreateLoadBalancer(lb *LoadBalancer) (err error) {
 		Tags       []string    `json:"tags"`
 	}{
 		Name: lb.Name,
-		Vdc:  lb.Vdc.ID,
+		Vdc:  v.ID,
 		Port: customPort{
 			ID:                lb.Port.ID,
 			IpAddress:         lb.Port.IpAddress,
@@ -211,13 +234,17 @@ func (v Vdc) CreateLoadBalancer(lb *LoadBalancer) (err error) {
 	}
 
 	if lb.Floating != nil {
-		lbCreate.Floating = &lb.Floating.ID
+		if lb.Floating.ID != "" {
+			lbCreate.Floating = &lb.Floating.ID
+		} else {
+			lbCreate.Floating = lb.Floating.IpAddress
+		}
 	}
 
-	if err = lb.manager.Request("POST", path, lbCreate, &lb); err != nil {
+	if err = v.manager.Request("POST", path, lbCreate, &lb); err != nil {
 		log.Printf("[REQUEST-ERROR] create-lbaas via vdc failed: %s", err)
 	} else {
-		lb.manager = v.manager
+		lb.setManager(v.manager)
 	}
 
 	return
@@ -253,6 +280,37 @@ func (lb *LoadBalancer) Update() (err error) {
 	return
 }
 
+func (lb *LoadBalancer) Rename(name string) error {
+	lb.Name = name
+	return lb.Update()
+}
+
+func (lb *LoadBalancer) Reload() (err error) {
+	path, _ := url.JoinPath("v1/lbaas", lb.ID)
+	m := lb.manager
+
+	if err = m.Get(path, Defaults(), &lb); err != nil {
+		log.Printf("[REQUEST-ERROR]: get-lbaas failed: %s", err)
+	} else {
+		lb.setManager(m)
+	}
+
+	return
+}
+
+func (lb *LoadBalancer) setManager(m *Manager) {
+	lb.manager = m
+	if lb.Port != nil {
+		lb.Port.manager = m
+	}
+	if lb.Vdc != nil {
+		lb.Vdc.manager = m
+	}
+	if lb.Floating != nil {
+		lb.Floating.manager = m
+	}
+}
+
 func (lb *LoadBalancer) Delete() (err error) {
 	path, _ := url.JoinPath("v1/lbaas", lb.ID)
 	if err = lb.manager.Delete(path, Defaults(), nil); err != nil {
@@ -269,6 +327,10 @@ func (lb *LoadBalancer) GetPools(extraArgs ...Arguments) (pools []*LoadBalancerP
 
 	if err = lb.manager.GetSubItems(path, args, &pools); err != nil {
 		log.Printf("[REQUEST-ERROR] get-lbaas-pools failed: %s", err)
+	} else {
+		for i := range pools {
+			pools[i].manager = lb.manager
+		}
 	}
 
 	return
@@ -286,31 +348,41 @@ func (lb *LoadBalancer) GetLoadBalancerPool(id string) (lbaas_pool LoadBalancerP
 	return
 }
 
-func (lb *LoadBalancer) CreatePool(pool *LoadBalancerPool) (err error) {
-	path := fmt.Sprintf("v1/lbaas/%s/pool", lb.ID)
-	type poolMember struct {
-		Port   int    `json:"port"`
-		Weight int    `json:"weight"`
-		Vm     string `json:"vm"`
-	}
-	var members []*poolMember
+type poolMemberArgs struct {
+	Port      int     `json:"port"`
+	Weight    int     `json:"weight"`
+	Vm        *string `json:"vm,omitempty"`
+	IpAddress *string `json:"ip_address,omitempty"`
+}
+
+// poolArgs always sends health_monitor, so null removes the monitor of the
+// pool on update.
+type poolArgs struct {
+	Port               int                        `json:"port"`
+	Connlimit          int                        `json:"connlimit"`
+	Members            []*poolMemberArgs          `json:"members"`
+	CookieName         *string                    `json:"cookie_name,omitempty"`
+	Method             string                     `json:"method,omitempty"`
+	Protocol           string                     `json:"protocol,omitempty"`
+	SessionPersistence string                     `json:"session_persistence,omitempty"`
+	HealthMonitor      *LoadBalancerHealthMonitor `json:"health_monitor"`
+}
+
+func (pool *LoadBalancerPool) args() *poolArgs {
+	var members []*poolMemberArgs
 	for _, member := range pool.Members {
-		members = append(members, &poolMember{
-			Port:   member.Port,
-			Weight: member.Weight,
-			Vm:     member.Vm.ID,
-		})
+		memberArgs := &poolMemberArgs{
+			Port:      member.Port,
+			Weight:    member.Weight,
+			IpAddress: member.IpAddress,
+		}
+		if member.Vm != nil {
+			memberArgs.Vm = &member.Vm.ID
+		}
+		members = append(members, memberArgs)
 	}
 
-	args := &struct {
-		Port               int           `json:"port"`
-		Connlimit          int           `json:"connlimit"`
-		Members            []*poolMember `json:"members"`
-		CookieName         *string       `json:"cookie_name,omitempty"`
-		Method             string        `json:"method,omitempty"`
-		Protocol           string        `json:"protocol,omitempty"`
-		SessionPersistence string        `json:"session_persistence,omitempty"`
-	}{
+	return &poolArgs{
 		Port:               pool.Port,
 		Connlimit:          pool.Connlimit,
 		Members:            members,
@@ -318,10 +390,17 @@ func (lb *LoadBalancer) CreatePool(pool *LoadBalancerPool) (err error) {
 		Protocol:           pool.Protocol,
 		SessionPersistence: pool.SessionPersistence,
 		CookieName:         pool.CookieName,
+		HealthMonitor:      pool.HealthMonitor,
 	}
+}
+
+func (lb *LoadBalancer) CreatePool(pool *LoadBalancerPool) (err error) {
+	path := fmt.Sprintf("v1/lbaas/%s/pool", lb.ID)
 
-	if err = lb.manager.Request("POST", path, args, &pool); err != nil {
+	if err = lb.manager.Request("POST", path, pool.args(), &pool); err != nil {
 		log.Printf("[REQUEST-ERROR] create-lbaas-pool failed: %s", err)
+	} else {
+		pool.manager = lb.manager
 	}
 
 	return
@@ -330,45 +409,41 @@ func (lb *LoadBalancer) CreatePool(pool *LoadBalancerPool) (err error) {
 func (lb *LoadBalancer) UpdatePool(pool *LoadBalancerPool) (err error) {
 	path := fmt.Sprintf("v1/lbaas/%s/pool/%s", lb.ID, pool.ID)
 
-	type poolMember struct {
-		Port   int    `json:"port"`
-		Weight int    `json:"weight"`
-		Vm     string `json:"vm"`
-	}
-	type createPool struct {
-		Port               int           `json:"port"`
-		Connlimit          int           `json:"connlimit"`
-		Members            []*poolMember `json:"members"`
-		Method             string        `json:"method,omitempty"`
-		Protocol           string        `json:"protocol,omitempty"`
-		CookieName         *string       `json:"cookie_name,omitempty"`
-		SessionPersistence string        `json:"session_persistence,omitempty"`
+	if err = lb.manager.Request("PUT", path, pool.args(), &pool); err != nil {
+		log.Printf("[REQUEST-ERROR] update-lbaas-pool failed: %s", err)
 	}
 
-	var members []*poolMember
-	for _, member := range pool.Members {
-		members = append(members, &poolMember{
-			Port:   member.Port,
-			Weight: member.Weight,
-			Vm:     member.Vm.ID,
-		})
-	}
+	return
+}
 
-	lbCreatePool := createPool{
-		Port:               pool.Port,
-		Connlimit:          pool.Connlimit,
-		Members:            members,
-		Method:             pool.Method,
-		Protocol:           pool.Protocol,
-		CookieName:         pool.CookieName,
-		SessionPersistence: pool.SessionPersistence,
-	}
+// AddPoolMember adds a backend to the pool and waits until the load
+// balancer has applied the change.
+func (lb *LoadBalancer) AddPoolMember(pool *LoadBalancerPool, member *PoolMember) error {
+	pool.Members = append(pool.Members, member)
+	return lb.applyPool(pool)
+}
 
-	if err := lb.manager.Request("PUT", path, lbCreatePool, &pool); err != nil {
-		log.Printf("[REQUEST-ERROR] update-lbaas-pool failed: %s", err)
+func (lb *LoadBalancer) RemovePoolMember(pool *LoadBalancerPool, member *PoolMember) error {
+	for i, poolMember := range pool.Members {
+		if poolMember == member || (member.ID != "" && poolMember.ID == member.ID) {
+			pool.Members = append(pool.Members[:i], pool.Members[i+1:]...)
+			break
+		}
 	}
+	return lb.applyPool(pool)
+}
 
-	return
+// SetPoolHealthMonitor replaces the health check of the pool, nil removes it.
+func (lb *LoadBalancer) SetPoolHealthMonitor(pool *LoadBalancerPool, monitor *LoadBalancerHealthMonitor) error {
+	pool.HealthMonitor = monitor
+	return lb.applyPool(pool)
+}
+
+func (lb *LoadBalancer) applyPool(pool *LoadBalancerPool) error {
+	if err := lb.UpdatePool(pool); err != nil {
+		return err
+	}
+	return lb.WaitLock()
 }
 
 func (lb *LoadBalancer) DeletePools() (err error) {
@@ -0,0 +1,123 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	L7RuleTypeHost = "HOST_NAME"
+	L7RuleTypePath = "PATH"
+
+	L7RuleCompareEqualTo    = "EQUAL_TO"
+	L7RuleCompareStartsWith = "STARTS_WITH"
+	L7RuleCompareEndsWith   = "ENDS_WITH"
+	L7RuleCompareRegex      = "REGEX"
+
+	L7RuleActionRedirectToPool = "REDIRECT_TO_POOL"
+	L7RuleActionReject         = "REJECT"
+)
+
+// LoadBalancerL7Rule routes the requests of a listener. The platform has no
+// separate listener object: every pool listens on its own Port, so the rules
+// of a listener are the rules of its pool and redirect to one of the other
+// pools of the load balancer.
+type LoadBalancerL7Rule struct {
+	manager      *Manager
+	lbId         string
+	poolId       string
+	ID           string  `json:"id"`
+	Type         string  `json:"type"`
+	CompareType  string  `json:"compare_type"`
+	Value        string  `json:"value"`
+	Action       string  `json:"action"`
+	RedirectPool *string `json:"redirect_pool,omitempty"`
+	Position     int     `json:"position,omitempty"`
+	Locked       bool    `json:"locked"`
+}
+
+// NewLoadBalancerL7Rule routes requests matching value to redirectPool.
+func NewLoadBalancerL7Rule(ruleType string, compareType string, value string, redirectPool *LoadBalancerPool) LoadBalancerL7Rule {
+	r := LoadBalancerL7Rule{Type: ruleType, CompareType: compareType, Value: value, Action: L7RuleActionReject}
+	if redirectPool != nil {
+		r.Action = L7RuleActionRedirectToPool
+		r.RedirectPool = &redirectPool.ID
+	}
+	return r
+}
+
+func (lb *LoadBalancer) GetL7Rules(pool *LoadBalancerPool, extraArgs ...Arguments) (rules []*LoadBalancerL7Rule, err error) {
+	path := fmt.Sprintf("v1/lbaas/%s/pool/%s/l7rule", lb.ID, pool.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = lb.manager.GetSubItems(path, args, &rules); err != nil {
+		log.Printf("[REQUEST-ERROR] get-lbaas-l7rule list failed: %s", err)
+	} else {
+		for i := range rules {
+			rules[i].manager = lb.manager
+			rules[i].lbId = lb.ID
+			rules[i].poolId = pool.ID
+		}
+	}
+
+	return
+}
+
+// CreateL7Rule adds a host or path based rule to the listener of pool, that
+// is the pool itself. The rule must not redirect back to pool.
+func (lb *LoadBalancer) CreateL7Rule(pool *LoadBalancerPool, rule *LoadBalancerL7Rule) (err error) {
+	path := fmt.Sprintf("v1/lbaas/%s/pool/%s/l7rule", lb.ID, pool.ID)
+
+	if err = rule.validate(pool.ID); err != nil {
+		log.Printf("[REQUEST-ERROR] create-lbaas-l7rule failed: %s", err)
+		return
+	}
+
+	if err = lb.manager.Request("POST", path, rule, &rule); err != nil {
+		log.Printf("[REQUEST-ERROR] create-lbaas-l7rule failed: %s", err)
+	} else {
+		rule.manager = lb.manager
+		rule.lbId = lb.ID
+		rule.poolId = pool.ID
+	}
+
+	return
+}
+
+func (r *LoadBalancerL7Rule) Update() (err error) {
+	path := fmt.Sprintf("v1/lbaas/%s/pool/%s/l7rule/%s", r.lbId, r.poolId, r.ID)
+
+	if err = r.validate(r.poolId); err != nil {
+		log.Printf("[REQUEST-ERROR] update-lbaas-l7rule failed: %s", err)
+		return
+	}
+
+	if err = r.manager.Request("PUT", path, r, &r); err != nil {
+		log.Printf("[REQUEST-ERROR] update-lbaas-l7rule failed: %s", err)
+	}
+
+	return
+}
+
+func (r *LoadBalancerL7Rule) validate(poolId string) error {
+	if r.RedirectPool != nil && *r.RedirectPool == poolId {
+		return errors.Errorf("l7 rule of pool '%s' redirects to its own pool", poolId)
+	}
+	return nil
+}
+
+func (r *LoadBalancerL7Rule) Delete() (err error) {
+	path := fmt.Sprintf("v1/lbaas/%s/pool/%s/l7rule/%s", r.lbId, r.poolId, r.ID)
+	if err = r.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-lbaas-l7rule failed: %s", err)
+	}
+	return
+}
+
+func (r LoadBalancerL7Rule) WaitLock() error {
+	path := fmt.Sprintf("v1/lbaas/%s/pool/%s/l7rule/%s", r.lbId, r.poolId, r.ID)
+	return loopWaitLock(r.manager, path)
+}
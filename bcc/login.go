@@ -0,0 +1,109 @@
+package bcc
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// OtpProvider returns the current one-time code of the second factor, e.g.
+// by asking the user or generating a TOTP code from a stored secret.
+type OtpProvider func() (string, error)
+
+type loginResponse struct {
+	Token       string `json:"token"`
+	OtpRequired bool   `json:"otp_required"`
+	Challenge   string `json:"challenge"`
+}
+
+// Login exchanges the credentials of an account for an API token which is
+// used for all further requests of the manager. Accounts with a second
+// factor enforced are asked for a code through otp, which may be nil
+// otherwise.
+func (m *Manager) Login(username string, password string, otp OtpProvider) (err error) {
+	args := &struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{
+		Username: username,
+		Password: password,
+	}
+
+	response := &loginResponse{}
+	if err = m.authRequest("v1/auth/login", args, response); err != nil {
+		log.Printf("[REQUEST-ERROR] login failed: %s", err)
+		return
+	}
+
+	if response.OtpRequired {
+		if otp == nil {
+			return errors.Errorf("account '%s' requires a second factor but no otp provider is given", username)
+		}
+
+		code, err := otp()
+		if err != nil {
+			return errors.Wrap(err, "get one-time code")
+		}
+
+		otpArgs := &struct {
+			Challenge string `json:"challenge"`
+			Code      string `json:"code"`
+		}{
+			Challenge: response.Challenge,
+			Code:      code,
+		}
+
+		response = &loginResponse{}
+		if err = m.authRequest("v1/auth/login/otp", otpArgs, response); err != nil {
+			log.Printf("[REQUEST-ERROR] login second factor failed: %s", err)
+			return err
+		}
+	}
+
+	if response.Token == "" {
+		return errors.New("login response does not contain a token")
+	}
+
+	m.Token = response.Token
+	return nil
+}
+
+// authRequest posts credentials without the payload logging of Request, so
+// passwords and codes do not end up in debug logs.
+func (m *Manager) authRequest(path string, args interface{}, target interface{}) error {
+	m.log("[bcc] POST %s", path)
+
+	body, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	requestUrl, _ := url.JoinPath(m.BaseURL, path)
+
+	req, err := http.NewRequest("POST", requestUrl, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] Invalid POST request %s", requestUrl)
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(m.ctx)
+
+	// the body is left out of the recorded result on purpose
+	resp, err := m.perform(req, requestUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if err = json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return errors.Wrapf(err, "JSON decode failed on %s", requestUrl)
+	}
+
+	return nil
+}
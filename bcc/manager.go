@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,7 +39,9 @@ type Manager struct {
 	RequestTimeout  time.Duration
 	RequestInterval time.Duration
 	UserAgent       string
+	DryRun          bool
 	ctx             context.Context
+	result          *Result
 }
 
 func loadCertificatesFromFile(CertPath string) (*x509.CertPool, error) {
@@ -67,9 +70,21 @@ type ObjectLocked struct {
 	NonFieldErrors []interface{} `json:"non_field_errors"`
 }
 
+// A task counts as finished once its status is none of the in progress ones,
+// TaskStatusError marks a failed task.
+const (
+	TaskStatusPending    = "pending"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusError      = "error"
+)
+
 type Task struct {
-	Status string `json:"status"`
-	Name   string `json:"name"`
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Name      string `json:"name"`
+	Progress  int    `json:"progress"`
+	CreatedAt Time   `json:"created_at"`
+	UpdatedAt Time   `json:"updated_at"`
 }
 
 type logger interface {
@@ -82,7 +97,10 @@ func getCaCert(cert string) (*x509.CertPool, error) {
 		certData, err := loadFile(cert)
 
 		if !certPool.AppendCertsFromPEM(certData) {
-			return nil, errors.Wrapf(err, "Error with append CA cert to pool %s ", cert)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Error with append CA cert to pool %s ", cert)
+			}
+			return nil, errors.Errorf("Error with append CA cert to pool %s ", cert)
 		}
 
 		return certPool, nil
@@ -92,23 +110,18 @@ func getCaCert(cert string) (*x509.CertPool, error) {
 	}
 }
 
-func getClientCert(caCert string, cert string, key string) ([]tls.Certificate, error) {
+func getClientCert(cert string, key string) ([]tls.Certificate, error) {
 	if cert != "" && key != "" {
-		if caCert != "" {
-			certData, fileErr := loadFile(cert)
-			keyData, keyErr := loadFile(key)
+		certData, fileErr := loadFile(cert)
+		keyData, keyErr := loadFile(key)
 
-			cert, err := tls.X509KeyPair(certData, keyData)
-			if err != nil {
-				return nil, fmt.Errorf("failed to load client certificate."+
-					" \n file_err: %w \n key_err: %w \n global_err: %w", fileErr, keyErr, err)
-			}
-
-			return []tls.Certificate{cert}, nil
-		} else {
-			return nil, fmt.Errorf("CaCert is empty, " +
-				"if you using client sert for connection, root cert must be required")
+		cert, err := tls.X509KeyPair(certData, keyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate."+
+				" \n file_err: %w \n key_err: %w \n global_err: %w", fileErr, keyErr, err)
 		}
+
+		return []tls.Certificate{cert}, nil
 	} else if cert != "" {
 		return nil, fmt.Errorf("client cert cannot be apply without key file")
 	} else if key != "" {
@@ -118,45 +131,45 @@ func getClientCert(caCert string, cert string, key string) ([]tls.Certificate, e
 	}
 }
 
-func NewManager(token string, caCert string, cert string, certKey string, insecure bool) (*Manager, error) {
-	var client *http.Client
+func NewManager(token string, caCert string, cert string, certKey string, insecure bool, opts ...ManagerOption) (*Manager, error) {
+	options := &managerOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 
 	certPool, err := getCaCert(caCert)
+	if options.caCertPEM != nil {
+		certPool, err = getCaCertFromPEM(options.caCertPEM)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if certPool != nil {
-		clientCerts, err := getClientCert(caCert, cert, certKey)
+	// without a custom CA the control panel is verified against the system roots
+	if certPool == nil {
+		certPool, err = x509.SystemCertPool()
 		if err != nil {
-			return nil, err
+			return nil, errors.Wrap(err, "Failed to load system cert pool")
 		}
+	}
 
-		client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					RootCAs:            certPool,
-					Certificates:       clientCerts,
-					InsecureSkipVerify: insecure,
-					MinVersion:         tls.VersionTLS12,
-				},
-			},
-		}
+	clientCerts, err := getClientCert(cert, certKey)
+	if options.clientCertPEM != nil || options.clientKeyPEM != nil {
+		clientCerts, err = getClientCertFromPEM(options.clientCertPEM, options.clientKeyPEM)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	} else if insecure == true {
-		client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: insecure,
-					MinVersion:         tls.VersionTLS12,
-				},
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:            certPool,
+				Certificates:       clientCerts,
+				InsecureSkipVerify: insecure,
+				MinVersion:         tls.VersionTLS12,
 			},
-		}
-
-	} else {
-		client = &http.Client{
-			Transport: &http.Transport{},
-		}
+		},
 	}
 
 	return &Manager{
@@ -165,7 +178,7 @@ func NewManager(token string, caCert string, cert string, certKey string, insecu
 
 		BaseURL:   DefaultBaseURL,
 		Token:     token,
-		UserAgent: "bcc-go",
+		UserAgent: defaultUserAgent,
 		ctx:       context.Background(),
 	}, nil
 }
@@ -176,12 +189,27 @@ func (m *Manager) WithContext(ctx context.Context) *Manager {
 	return &newManager
 }
 
+// SetApplication appends an application identifier like
+// "terraform-provider-bcc/1.2.3" to the User-Agent sent with every request.
+func (m *Manager) SetApplication(app string) {
+	m.UserAgent = fmt.Sprintf("%s %s", defaultUserAgent, app)
+}
+
 func (m *Manager) Request(method string, path string, args interface{}, target interface{}) error {
+	taskIds, err := m.requestTasks(method, path, args, target)
+	m.waitTasks(taskIds)
+
+	return err
+}
+
+// requestTasks sends the request like Request but returns the ids of the
+// started tasks instead of waiting for them.
+func (m *Manager) requestTasks(method string, path string, args interface{}, target interface{}) (string, error) {
 	m.log("[request-info] method:%s path:%s payload:%s", method, path, args)
 
 	res, err := json.Marshal(args)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	requestUrl, _ := url.JoinPath(m.BaseURL, path)
@@ -189,17 +217,19 @@ func (m *Manager) Request(method string, path string, args interface{}, target i
 	req, err := http.NewRequest(method, requestUrl, bytes.NewReader(res))
 	if err != nil {
 		log.Printf("[REQUEST-ERROR] Invalid %s request %s", method, requestUrl)
-		return err
+		return "", err
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.Token))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(m.ctx)
 
-	taskIds, err := m.do(req, requestUrl, target, res)
-	m.waitTasks(taskIds)
+	return m.do(req, requestUrl, target, res)
+}
 
-	return err
+// Patch sends a partial update, only the fields present in args are changed.
+func (m *Manager) Patch(path string, args interface{}, target interface{}) error {
+	return m.Request(http.MethodPatch, path, args, target)
 }
 
 func (m *Manager) Get(path string, args Arguments, target interface{}) error {
@@ -225,6 +255,12 @@ func (m *Manager) Get(path string, args Arguments, target interface{}) error {
 }
 
 func (m *Manager) GetItems(path string, args Arguments, target interface{}) error {
+	return m.getItems(path, args, target, 0)
+}
+
+// getItems is GetItems which stops fetching pages once maxItems items are
+// collected, a zero maxItems fetches all of them.
+func (m *Manager) getItems(path string, args Arguments, target interface{}, maxItems int) error {
 	targetValue := reflect.ValueOf(target)
 	if reflect.TypeOf(target).Kind() == reflect.Pointer {
 		targetValue = targetValue.Elem()
@@ -235,6 +271,13 @@ func (m *Manager) GetItems(path string, args Arguments, target interface{}) erro
 
 	params := args.ToURLValues()
 
+	requestedLimit, _ := strconv.Atoi(params.Get("limit"))
+	start := time.Now()
+	pager := m
+	pageSize := 0
+	total := 0
+	collected := 0
+
 	page := 1
 	for {
 		params.Set("page", fmt.Sprint(page))
@@ -262,11 +305,12 @@ func (m *Manager) GetItems(path string, args Arguments, target interface{}) erro
 
 		temp := new(tempStruct)
 
-		_, err = m.do(req, request_url, temp, nil)
+		_, err = pager.do(req, request_url, temp, nil)
 		if err != nil {
-			break
+			return errors.Wrapf(err, "GET items failed on %s, page %d", path, page)
 		}
-		currentPageSize := min(temp.Total-temp.Limit*(page-1), temp.Limit)
+		total = temp.Total
+		currentPageSize := max(0, min(temp.Total-collected, temp.Limit))
 		currentItemsValue := reflect.New(targetValue.Type())
 		currentItemsValue.Elem().Set(reflect.MakeSlice(targetValue.Type(), 0, currentPageSize))
 		currentItems := currentItemsValue.Interface()
@@ -274,12 +318,42 @@ func (m *Manager) GetItems(path string, args Arguments, target interface{}) erro
 		if err != nil {
 			return errors.Wrapf(err, "JSON items decode failed on %s, page %d:", path, page)
 		}
+		received := currentItemsValue.Elem().Len()
 		targetValue.Set(reflect.AppendSlice(targetValue, currentItemsValue.Elem()))
-		if targetValue.Len() == temp.Total {
+		collected += received
+		if maxItems > 0 && collected >= maxItems {
+			targetValue.Set(targetValue.Slice(0, targetValue.Len()-(collected-maxItems)))
+			break
+		}
+		if collected >= temp.Total || received == 0 {
 			break
 		}
+
+		// Some endpoints clamp the limit silently. Keep asking for the page
+		// size the server really uses, so page offsets stay consistent.
+		if page == 1 {
+			// only the first page is recorded as the result of the call
+			pager = m.withoutResult()
+			pageSize = received
+			if temp.Limit > 0 && temp.Limit < pageSize {
+				pageSize = temp.Limit
+			}
+			if requestedLimit > 0 && pageSize < requestedLimit {
+				m.log("[bcc] %s clamps limit %d to %d", path, requestedLimit, pageSize)
+				params.Set("limit", fmt.Sprint(pageSize))
+			}
+		}
 		page++
 	}
+	if pageSize == 0 {
+		pageSize = collected
+	}
+	if m.result != nil {
+		m.result.Pages = page
+		m.result.PageSize = pageSize
+		m.result.Total = total
+		m.result.Duration = time.Since(start)
+	}
 	m.log("[bcc] Retrieved items: %+v", target)
 	return nil
 }
@@ -308,6 +382,37 @@ func (m *Manager) GetSubItems(path string, args Arguments, target interface{}) e
 	return nil
 }
 
+// Download streams the body of a GET request on path into w without keeping
+// the whole payload in memory.
+func (m *Manager) Download(path string, w io.Writer) error {
+	m.log("[bcc] GET %s", path)
+
+	requestUrl, _ := url.JoinPath(m.BaseURL, path)
+
+	req, err := http.NewRequest("GET", requestUrl, nil)
+	if err != nil {
+		log.Printf("Invalid GET request %s", requestUrl)
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.Token))
+
+	req = req.WithContext(m.ctx)
+
+	resp, err := m.perform(req, requestUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if _, err = io.Copy(w, resp.Body); err != nil {
+		return errors.Wrapf(err, "HTTP Read error on response for %s", requestUrl)
+	}
+
+	return nil
+}
+
 func (m *Manager) Delete(path string, args Arguments, target interface{}) error {
 	m.log("[bcc] DELETE %s", path)
 
@@ -360,6 +465,14 @@ func (m *Manager) WaitTask(taskId string) error {
 	return nil
 }
 
+func (m *Manager) GetTask(taskId string) (task *Task, err error) {
+	path, _ := url.JoinPath("v1/job", taskId)
+
+	task = &Task{}
+	err = m.Get(path, Arguments{}, task)
+	return
+}
+
 func (m *Manager) log(format string, args ...interface{}) {
 	if m.Logger != nil {
 		m.Logger.Debugf(format, args...)
@@ -377,7 +490,67 @@ func (m *Manager) sleep(dur time.Duration) error {
 }
 
 func (m *Manager) do(req *http.Request, url string, target interface{}, requestBody []byte) (string, error) {
+	if m.skipDryRun(req, requestBody) {
+		return "", nil
+	}
+
+	resp, err := m.perform(req, url, requestBody)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "HTTP Read error on response for %s", url)
+	}
+
+	// task waiter
+	taskIds := resp.Header.Get("X-Esu-Tasks")
+	if taskIds != "" {
+		m.log("[bcc] Tasks IDS: %s", taskIds)
+	}
+
+	if len(b) == 0 {
+		return taskIds, nil
+	}
+
+	if target == nil {
+		// Don't try to unmarshall in case target is nil
+		return taskIds, nil
+	}
+
+	err = json.Unmarshal(b, target)
+	if err != nil {
+		return "", errors.Wrapf(err, "JSON decode failed on %s:\n%s", url, string(b))
+	}
+
+	return taskIds, nil
+}
+
+// perform sends the request, retrying while the object is locked, and returns
+// the successful response with its body left unread for the caller.
+func (m *Manager) perform(req *http.Request, url string, requestBody []byte) (*http.Response, error) {
+	resp, err := m.send(req, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		m.log("[bcc] Error response %d on '%s'", resp.StatusCode, url)
+		return nil, NewApiError(url, resp)
+	} else {
+		m.log("[bcc] Success response on '%s'", url)
+	}
+
+	return resp, nil
+}
+
+func (m *Manager) send(req *http.Request, url string, requestBody []byte) (*http.Response, error) {
 	req.Header.Set("Accept-Language", "ru-ru")
+	req.Header.Set("User-Agent", m.UserAgent)
 
 	var lockedObject ObjectLocked
 	var resp *http.Response
@@ -388,25 +561,40 @@ func (m *Manager) do(req *http.Request, url string, target interface{}, requestB
 	ticker := time.NewTicker(m.RequestInterval)
 	defer ticker.Stop()
 
+	start := time.Now()
+	retries := 0
+	defer func() { m.recordResult(req, requestBody, resp, start, retries) }()
+
 	for {
 		m.log("[bcc] Perform %s...", req.Method)
 
-		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, errors.Wrapf(err, "HTTP request body failure on %s", url)
+			}
+			req.Body = body
+		} else if retries > 0 && req.Body != nil {
+			// streamed bodies are consumed by the first attempt
+			return nil, errors.Errorf("Object '%s' locked, streamed request cannot be retried", url)
+		}
+
 		resp_, err := m.Client.Do(req)
 		if err != nil {
-			return "", errors.Wrapf(err, "HTTP request failure on %s", url)
+			return nil, errors.Wrapf(err, "HTTP request failure on %s", url)
 		}
 
-		defer resp_.Body.Close()
+		resp = resp_
 
 		if resp_.StatusCode == 409 {
 			m.log("[bcc] Object '%s' locked. Try again in %dms...", url, RetryTime)
 
 			body, err := io.ReadAll(resp_.Body)
+			resp_.Body.Close()
 			err = json.Unmarshal(body, &lockedObject)
 
 			if err != nil {
-				return "", errors.Wrapf(err, "HTTP Read error on response for %s", url)
+				return nil, errors.Wrapf(err, "HTTP Read error on response for %s", url)
 			}
 
 			if lockedObject.ErrorAlias != nil {
@@ -415,69 +603,25 @@ func (m *Manager) do(req *http.Request, url string, target interface{}, requestB
 				errorData := fmt.Sprintf("%v", lockedObject.NonFieldErrors[0])
 				if errorAlias != "object_locked" {
 					errorBody := fmt.Sprintf("%s: %s", errorData, string(errorDetails))
-					return "", errors.New(errorBody)
+					return nil, errors.New(errorBody)
 				}
 			}
 
 			select {
 			case <-ctx.Done():
 				m.log("[request-err] Waiting unlock for '%s' took more than %ds", url, m.RequestTimeout.Seconds())
-				return "", ctx.Err()
+				return nil, ctx.Err()
 			case <-ticker.C:
 			}
 
+			retries++
 			continue
 		}
 
-		resp = resp_
 		break
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		m.log("[bcc] Error response %d on '%s'", resp.StatusCode, url)
-		return "", NewApiError(url, resp)
-	} else {
-		m.log("[bcc] Success response on '%s'", url)
-	}
-
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", errors.Wrapf(err, "HTTP Read error on response for %s", url)
-	}
-
-	// task waiter
-	taskIds := resp.Header.Get("X-Esu-Tasks")
-	if taskIds != "" {
-		m.log("[bcc] Tasks IDS: %s", taskIds)
-	}
-
-	if len(b) == 0 {
-		return taskIds, nil
-	}
-
-	if target == nil {
-		// Don't try to unmarshall in case target is nil
-		return taskIds, nil
-	}
-
-	// if we dowload file
-	if strings.Contains(url, "config") {
-		reg_url := fmt.Sprintf("%s%s", m.BaseURL, KubeCtlConfigURL)
-		err = CreateKubeCtlConfigFile(b, url, reg_url)
-		if err != nil {
-			return "", errors.Wrapf(err, "Error while creating config file")
-		}
-	} else {
-		err = json.Unmarshal(b, target)
-		log.Printf("Unmarshalled response: %+v", target)
-		log.Printf("%s", b)
-		log.Printf("%s", string(b))
-		if err != nil {
-			return "", errors.Wrapf(err, "JSON decode failed on %s:\n%s", url, string(b))
-		}
-	}
-
-	return taskIds, nil
+	return resp, nil
 }
 
 func CreateKubeCtlConfigFile(b []byte, url string, reg_url string) (err error) {
@@ -505,6 +649,7 @@ func CreateKubeCtlConfigFile(b []byte, url string, reg_url string) (err error) {
 }
 
 func (m *Manager) waitTasks(taskIds string) error {
+	m = m.withoutResult()
 	for _, taskId := range strings.Split(taskIds, ",") {
 		taskId := strings.TrimSpace(taskId)
 		if taskId == "" {
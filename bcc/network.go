@@ -3,6 +3,7 @@ package bcc
 import (
 	"fmt"
 	"log"
+	"net/netip"
 	"net/url"
 
 	"github.com/pkg/errors"
@@ -14,6 +15,7 @@ type Network struct {
 	Name      string `json:"name"`
 	IsDefault bool   `json:"is_default"`
 	External  bool   `json:"external"`
+	Shared    bool   `json:"shared"`
 	Mtu       *int   `json:"mtu,omitempty"`
 	Vdc       struct {
 		Id   string `json:"id"`
@@ -24,6 +26,37 @@ type Network struct {
 	Tags    []Tag    `json:"tags"`
 }
 
+type NetworkPatch struct {
+	Name *string   `json:"name,omitempty"`
+	Mtu  *int      `json:"mtu,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+const (
+	IpVersion4 = 4
+	IpVersion6 = 6
+
+	Ipv6ModeSlaac         = "slaac"
+	Ipv6ModeDhcpStateful  = "dhcpv6-stateful"
+	Ipv6ModeDhcpStateless = "dhcpv6-stateless"
+)
+
+// IpVersionOf returns IpVersion4 or IpVersion6 for an address or cidr, and 0
+// when it can not be parsed.
+func IpVersionOf(address string) int {
+	if prefix, err := netip.ParsePrefix(address); err == nil {
+		address = prefix.Addr().String()
+	}
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return 0
+	}
+	if addr.Is4() || addr.Is4In6() {
+		return IpVersion4
+	}
+	return IpVersion6
+}
+
 func NewNetwork(name string) Network {
 	n := Network{Name: name}
 	return n
@@ -70,7 +103,7 @@ func (m *Manager) GetNetwork(id string) (network *Network, err error) {
 	return
 }
 
-func (v *Vdc) CreateNetwork(network *Network) error {
+func (v *Vdc) CreateNetwork(network *Network) (err error) {
 	path := "v1/network"
 	args := &struct {
 		Name string   `json:"name"`
@@ -84,13 +117,13 @@ func (v *Vdc) CreateNetwork(network *Network) error {
 		Tags: convertTagsToNames(network.Tags),
 	}
 
-	if err := v.manager.Request("POST", path, args, &network); err != nil {
+	if err = v.manager.Request("POST", path, args, &network); err != nil {
 		log.Printf("[REQUEST-ERROR]: create-network failed: %s", err)
 	} else {
 		network.manager = v.manager
 	}
 
-	return nil
+	return
 }
 
 func (n *Network) GetSubnets(extraArgs ...Arguments) (subnets []*Subnet, err error) {
@@ -121,6 +154,17 @@ func (n *Network) CreateSubnet(subnet *Subnet) (err error) {
 	return
 }
 
+// HasIpv6 reports whether the network has an IPv6 subnet, so ports in it get
+// dual-stack addresses.
+func (n *Network) HasIpv6() bool {
+	for _, subnet := range n.Subnets {
+		if subnet.IpVersion == IpVersion6 || IpVersionOf(subnet.CIDR) == IpVersion6 {
+			return true
+		}
+	}
+	return false
+}
+
 func (n *Network) Rename(name string) error {
 	n.Name = name
 	return n.Update()
@@ -145,6 +189,16 @@ func (n *Network) Update() (err error) {
 	return
 }
 
+func (n *Network) Patch(patch NetworkPatch) (err error) {
+	path, _ := url.JoinPath("v1/network", n.ID)
+
+	if err = n.manager.Patch(path, patch, n); err != nil {
+		log.Printf("[REQUEST-ERROR]: patch-network failed: %s", err)
+	}
+
+	return
+}
+
 func (n *Network) Delete() (err error) {
 	path, _ := url.JoinPath("v1/network", n.ID)
 	if err = n.manager.Delete(path, Defaults(), nil); err != nil {
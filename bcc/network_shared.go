@@ -0,0 +1,73 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+// GetSharedNetworks lists platform and service networks shared with the
+// client, which can be connected to its vdcs.
+func (m *Manager) GetSharedNetworks(extraArgs ...Arguments) (networks []*Network, err error) {
+	args := Arguments{
+		"shared": "true",
+	}
+	args.merge(extraArgs)
+	networks, err = m.GetNetworks(args)
+	return
+}
+
+func (n *Network) ConnectVdc(vdc *Vdc) (err error) {
+	path := fmt.Sprintf("v1/network/%s/connect", n.ID)
+	args := &struct {
+		Vdc string `json:"vdc"`
+	}{
+		Vdc: vdc.ID,
+	}
+
+	if err = n.manager.Request("POST", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR]: connect-network with id='%s' to vdc '%s' failed: %s", n.ID, vdc.ID, err)
+	}
+
+	return
+}
+
+func (n *Network) DisconnectVdc(vdc *Vdc) (err error) {
+	path := fmt.Sprintf("v1/network/%s/disconnect", n.ID)
+	args := &struct {
+		Vdc string `json:"vdc"`
+	}{
+		Vdc: vdc.ID,
+	}
+
+	if err = n.manager.Request("POST", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR]: disconnect-network with id='%s' from vdc '%s' failed: %s", n.ID, vdc.ID, err)
+	}
+
+	return
+}
+
+// ConnectNetwork plugs the router into the network with a new port.
+func (r *Router) ConnectNetwork(network *Network) (port *Port, err error) {
+	port = &Port{Network: network}
+	if err = r.ConnectPort(port, false); err == nil {
+		r.Ports = append(r.Ports, port)
+	}
+	return
+}
+
+// DisconnectNetwork removes the ports connecting the router to the network.
+func (r *Router) DisconnectNetwork(network *Network) (err error) {
+	ports := make([]*Port, len(r.Ports))
+	copy(ports, r.Ports)
+
+	for _, port := range ports {
+		if port.Network == nil || port.Network.ID != network.ID {
+			continue
+		}
+		if err = r.DisconnectPort(port); err != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,84 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+)
+
+const (
+	NotificationTypeQuota       = "quota"
+	NotificationTypeMaintenance = "maintenance"
+	NotificationTypeBilling     = "billing"
+
+	NotificationLevelInfo     = "info"
+	NotificationLevelWarning  = "warning"
+	NotificationLevelCritical = "critical"
+)
+
+type Notification struct {
+	manager   *Manager
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Level     string    `json:"level"`
+	Title     string    `json:"title"`
+	Message   string    `json:"message"`
+	Read      bool      `json:"read"`
+	Project   *MetaData `json:"project,omitempty"`
+	CreatedAt Time      `json:"created_at"`
+}
+
+// GetNotifications lists the notifications of the account, filter with e.g.
+// Arguments{"type": NotificationTypeQuota, "read": "false"}.
+func (m *Manager) GetNotifications(extraArgs ...Arguments) (notifications []*Notification, err error) {
+	path := "v1/notification"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &notifications); err != nil {
+		log.Printf("[REQUEST-ERROR] get-notification list failed: %s", err)
+	} else {
+		for i := range notifications {
+			notifications[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (m *Manager) GetUnreadNotifications(extraArgs ...Arguments) (notifications []*Notification, err error) {
+	args := Arguments{
+		"read": "false",
+	}
+	args.merge(extraArgs)
+	notifications, err = m.GetNotifications(args)
+	return
+}
+
+func (n *Notification) MarkRead() (err error) {
+	path := fmt.Sprintf("v1/notification/%s/read", n.ID)
+
+	if err = n.manager.Request("POST", path, nil, n); err != nil {
+		log.Printf("[REQUEST-ERROR] mark-read-notification failed: %s", err)
+	}
+
+	return
+}
+
+func (m *Manager) MarkAllNotificationsRead() (err error) {
+	path := "v1/notification/read"
+
+	if err = m.Request("POST", path, nil, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] mark-read-notification list failed: %s", err)
+	}
+
+	return
+}
+
+func (n *Notification) Delete() (err error) {
+	path, _ := url.JoinPath("v1/notification", n.ID)
+	if err = n.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-notification failed: %s", err)
+	}
+	return
+}
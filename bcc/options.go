@@ -0,0 +1,56 @@
+package bcc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+type ManagerOption func(*managerOptions)
+
+type managerOptions struct {
+	caCertPEM     []byte
+	clientCertPEM []byte
+	clientKeyPEM  []byte
+}
+
+// WithCACertPEM trusts the given PEM encoded CA certificates instead of the
+// caCert path passed to NewManager.
+func WithCACertPEM(pem []byte) ManagerOption {
+	return func(o *managerOptions) {
+		o.caCertPEM = pem
+	}
+}
+
+// WithClientCertPEM uses the given PEM encoded client certificate and key
+// instead of the cert and certKey paths passed to NewManager.
+func WithClientCertPEM(cert []byte, key []byte) ManagerOption {
+	return func(o *managerOptions) {
+		o.clientCertPEM = cert
+		o.clientKeyPEM = key
+	}
+}
+
+func getCaCertFromPEM(pem []byte) (*x509.CertPool, error) {
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("Failed to append CA cert PEM to pool")
+	}
+	return certPool, nil
+}
+
+func getClientCertFromPEM(cert []byte, key []byte) ([]tls.Certificate, error) {
+	if len(cert) == 0 {
+		return nil, fmt.Errorf("client key cannot be apply without client cert")
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("client cert cannot be apply without key")
+	}
+
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate from PEM: %w", err)
+	}
+
+	return []tls.Certificate{certificate}, nil
+}
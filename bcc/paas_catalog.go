@@ -0,0 +1,76 @@
+package bcc
+
+import (
+	"log"
+)
+
+type PaasCatalogVersion struct {
+	Version    string `json:"version"`
+	Default    bool   `json:"default"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+type PaasCatalogFlavor struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Cpu         int     `json:"cpu"`
+	Ram         float64 `json:"ram"`
+	MinDiskSize int     `json:"min_disk_size"`
+}
+
+// PaasCatalogService describes a managed service which can be deployed on a
+// hypervisor, together with the versions and flavors offered for it.
+type PaasCatalogService struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	DisplayName string                `json:"display_name"`
+	Description string                `json:"description"`
+	Category    string                `json:"category"`
+	Versions    []*PaasCatalogVersion `json:"versions"`
+	Flavors     []*PaasCatalogFlavor  `json:"flavors"`
+}
+
+func (m *Manager) GetPaasCatalog(extraArgs ...Arguments) (services []*PaasCatalogService, err error) {
+	path := "v1/paas_catalog"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &services); err != nil {
+		log.Printf("[REQUEST-ERROR] get-paas-catalog list failed: %s", err)
+	}
+
+	return
+}
+
+func (h *Hypervisor) GetPaasCatalog(extraArgs ...Arguments) (services []*PaasCatalogService, err error) {
+	args := Arguments{
+		"hypervisor": h.ID,
+	}
+	args.merge(extraArgs)
+	services, err = h.manager.GetPaasCatalog(args)
+	return
+}
+
+// GetPaasCatalog lists the services offered in the segment of the vdc.
+func (v *Vdc) GetPaasCatalog(extraArgs ...Arguments) (services []*PaasCatalogService, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	services, err = v.manager.GetPaasCatalog(args)
+	return
+}
+
+// DefaultVersion returns the version the platform deploys when none is
+// given, or the first listed one.
+func (s *PaasCatalogService) DefaultVersion() *PaasCatalogVersion {
+	for _, version := range s.Versions {
+		if version.Default {
+			return version
+		}
+	}
+	if len(s.Versions) > 0 {
+		return s.Versions[0]
+	}
+	return nil
+}
@@ -10,12 +10,28 @@ type Port struct {
 	manager           *Manager
 	ID                string              `json:"id"`
 	IpAddress         *string             `json:"ip_address,omitempty"`
+	Ipv6Address       *string             `json:"ipv6_address,omitempty"`
 	Network           *Network            `json:"network"`
 	FirewallTemplates []*FirewallTemplate `json:"fw_templates,omitempty"`
 	Connected         *Connected          `json:"connected"`
 	Locked            bool                `json:"locked"`
 	Tags              []Tag               `json:"tags"`
 	Vdc               *Vdc                `json:"vdc,omitempty"`
+
+	PortSecurity        *bool                 `json:"port_security,omitempty"`
+	AllowedAddressPairs []*AllowedAddressPair `json:"allowed_address_pairs,omitempty"`
+}
+
+// AllowedAddressPair lets traffic from an extra address through the port,
+// e.g. a VRRP virtual ip shared by several vms.
+type AllowedAddressPair struct {
+	IpAddress  string `json:"ip_address"`
+	MacAddress string `json:"mac_address,omitempty"`
+}
+
+func NewAllowedAddressPair(ipAddress string) AllowedAddressPair {
+	a := AllowedAddressPair{IpAddress: ipAddress}
+	return a
 }
 
 type Connected struct {
@@ -30,25 +46,43 @@ func NewPort(network *Network, firewallTemplates []*FirewallTemplate, ipAddress
 	return p
 }
 
-func (v *Vdc) GetPorts(extraArgs ...Arguments) (ports []*Port, err error) {
+func (m *Manager) GetPorts(extraArgs ...Arguments) (ports []*Port, err error) {
 	path := "v1/port"
-	args := Arguments{
-		"vdc": v.ID,
-	}
+	args := Defaults()
 	args.merge(extraArgs)
 
-	if err = v.manager.GetItems(path, args, &ports); err != nil {
+	if err = m.GetItems(path, args, &ports); err != nil {
 		log.Printf("[REQUEST-ERROR]: get-port list failed: %s", err)
 	} else {
 		for i := range ports {
-			ports[i].manager = v.manager
-			ports[i].Network.manager = v.manager
+			ports[i].manager = m
+			if ports[i].Network != nil {
+				ports[i].Network.manager = m
+			}
 		}
 	}
 
 	return
 }
 
+func (v *Vdc) GetPorts(extraArgs ...Arguments) (ports []*Port, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	ports, err = v.manager.GetPorts(args)
+	return
+}
+
+func (v *Vm) GetPorts(extraArgs ...Arguments) (ports []*Port, err error) {
+	args := Arguments{
+		"vm": v.ID,
+	}
+	args.merge(extraArgs)
+	ports, err = v.manager.GetPorts(args)
+	return
+}
+
 func (m *Manager) GetPort(id string) (port *Port, err error) {
 	path, _ := url.JoinPath("v1/port", id)
 
@@ -67,6 +101,7 @@ func (r *Router) CreatePort(port *Port, toConnect interface{}) (err error) {
 		manager           *Manager
 		ID                string              `json:"id"`
 		IpAddress         *string             `json:"ip_address,omitempty"`
+		Ipv6Address       *string             `json:"ipv6_address,omitempty"`
 		Network           *string             `json:"network,omitempty"`
 		Router            string              `json:"router,omitempty"`
 		Vm                string              `json:"vm,omitempty"`
@@ -76,6 +111,7 @@ func (r *Router) CreatePort(port *Port, toConnect interface{}) (err error) {
 	}{
 		ID:                port.ID,
 		IpAddress:         port.IpAddress,
+		Ipv6Address:       port.Ipv6Address,
 		Network:           nil,
 		FirewallTemplates: port.FirewallTemplates,
 		Vdc:               nil,
@@ -99,6 +135,8 @@ func (r *Router) CreatePort(port *Port, toConnect interface{}) (err error) {
 
 	if err = r.manager.Request("POST", path, args, &port); err != nil {
 		log.Printf("[REQUEST-ERROR]: create-port with id='%s' failed: %s", port.ID, err)
+	} else {
+		port.manager = r.manager
 	}
 
 	return
@@ -114,6 +152,7 @@ func (v *Vdc) CreateEmptyPort(port *Port) (err error) {
 		manager     *Manager
 		ID          string    `json:"id"`
 		IpAddress   *string   `json:"ip_address,omitempty"`
+		Ipv6Address *string   `json:"ipv6_address,omitempty"`
 		Network     *string   `json:"network,omitempty"`
 		FwTemplates []*string `json:"fw_templates"`
 		Tags        []string  `json:"tags"`
@@ -121,6 +160,7 @@ func (v *Vdc) CreateEmptyPort(port *Port) (err error) {
 	}{
 		ID:          port.ID,
 		IpAddress:   port.IpAddress,
+		Ipv6Address: port.Ipv6Address,
 		Network:     nil,
 		FwTemplates: fwTemplates,
 		Tags:        convertTagsToNames(port.Tags),
@@ -148,11 +188,26 @@ func (p *Port) UpdateFirewall(firewallTemplates []*FirewallTemplate) error {
 	return p.Update()
 }
 
+// SetFirewallTemplates replaces the firewall templates bound to the port.
+func (p *Port) SetFirewallTemplates(firewallTemplates []*FirewallTemplate) error {
+	return p.UpdateFirewall(firewallTemplates)
+}
+
 func (p *Port) UpdateIpAddress(ip_address *string) error {
 	p.IpAddress = ip_address
 	return p.Update()
 }
 
+// UpdateAddress sets the fixed address of the port for the family of ip.
+func (p *Port) UpdateAddress(ip string) error {
+	if IpVersionOf(ip) == IpVersion6 {
+		p.Ipv6Address = &ip
+	} else {
+		p.IpAddress = &ip
+	}
+	return p.Update()
+}
+
 func (p *Port) Update() (err error) {
 	path, _ := url.JoinPath("v1/port", p.ID)
 	fwTemplates := make([]*string, 0)
@@ -161,11 +216,13 @@ func (p *Port) Update() (err error) {
 	}
 	args := &struct {
 		IpAddress     *string   `json:"ip_address,omitempty"`
+		Ipv6Address   *string   `json:"ipv6_address,omitempty"`
 		FwTemplates   []*string `json:"fw_templates"`
 		SecurityRules []string  `json:"security_rules"`
 		Tags          []string  `json:"tags"`
 	}{
 		IpAddress:     p.IpAddress,
+		Ipv6Address:   p.Ipv6Address,
 		FwTemplates:   fwTemplates,
 		SecurityRules: []string{},
 		Tags:          convertTagsToNames(p.Tags),
@@ -178,6 +235,83 @@ func (p *Port) Update() (err error) {
 	return
 }
 
+// ConnectVm attaches the port to a running vm as an additional nic.
+func (p *Port) ConnectVm(vm *Vm) error {
+	return vm.ConnectPort(p, true)
+}
+
+// Disconnect detaches the port from the vm or router it is connected to,
+// keeping the port and its ip address.
+func (p *Port) Disconnect() (err error) {
+	path := fmt.Sprintf("v1/port/%s/disconnect", p.ID)
+
+	if err = p.manager.Request("PATCH", path, nil, nil); err != nil {
+		log.Printf("[REQUEST-ERROR]: disconnect-port with id='%s' failed: %s", p.ID, err)
+	} else {
+		p.Connected = nil
+	}
+
+	return
+}
+
+func (p *Port) Reload() (err error) {
+	path, _ := url.JoinPath("v1/port", p.ID)
+	m := p.manager
+
+	if err = m.Get(path, Defaults(), &p); err != nil {
+		log.Printf("[REQUEST-ERROR]: get-port with id='%s' failed: %s", p.ID, err)
+	} else {
+		p.manager = m
+	}
+
+	return
+}
+
+func (p *Port) EnablePortSecurity() error {
+	return p.setPortSecurity(true)
+}
+
+// DisablePortSecurity turns off anti-spoofing and firewall filtering on the
+// port. Allowed address pairs are ignored while it is disabled.
+func (p *Port) DisablePortSecurity() error {
+	return p.setPortSecurity(false)
+}
+
+func (p *Port) setPortSecurity(enabled bool) (err error) {
+	path, _ := url.JoinPath("v1/port", p.ID)
+	args := &struct {
+		PortSecurity bool `json:"port_security"`
+	}{
+		PortSecurity: enabled,
+	}
+
+	if err = p.manager.Patch(path, args, p); err != nil {
+		log.Printf("[REQUEST-ERROR]: set-port-security with id='%s' failed: %s", p.ID, err)
+	}
+
+	return
+}
+
+// SetAllowedAddressPairs replaces the allowed address pairs of the port, an
+// empty list removes them all.
+func (p *Port) SetAllowedAddressPairs(pairs []*AllowedAddressPair) (err error) {
+	path, _ := url.JoinPath("v1/port", p.ID)
+	if pairs == nil {
+		pairs = []*AllowedAddressPair{}
+	}
+	args := &struct {
+		AllowedAddressPairs []*AllowedAddressPair `json:"allowed_address_pairs"`
+	}{
+		AllowedAddressPairs: pairs,
+	}
+
+	if err = p.manager.Patch(path, args, p); err != nil {
+		log.Printf("[REQUEST-ERROR]: set-allowed-address-pairs with id='%s' failed: %s", p.ID, err)
+	}
+
+	return
+}
+
 func (p *Port) Delete() (err error) {
 	path, _ := url.JoinPath("v1/port", p.ID)
 	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
@@ -0,0 +1,87 @@
+package bcc
+
+import (
+	"log"
+
+	"github.com/pkg/errors"
+)
+
+// HoursPerMonth is the average amount of hours in a month the platform bills
+// monthly prices with.
+const HoursPerMonth = 730
+
+type StoragePrice struct {
+	StorageProfile *MetaData `json:"storage_profile"`
+	// Price is per GB and hour.
+	Price float64 `json:"price"`
+}
+
+// Pricing is the hourly tariff of the client resources.
+type Pricing struct {
+	Currency string `json:"currency"`
+	// Cpu is the price per vCPU and hour.
+	Cpu float64 `json:"cpu"`
+	// Ram is the price per GB and hour.
+	Ram        float64         `json:"ram"`
+	FloatingIp float64         `json:"floating_ip"`
+	Storage    []*StoragePrice `json:"storage"`
+}
+
+func (m *Manager) GetPricing(extraArgs ...Arguments) (pricing *Pricing, err error) {
+	path := "v1/pricing"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	pricing = &Pricing{}
+	if err = m.Get(path, args, pricing); err != nil {
+		log.Printf("[REQUEST-ERROR] get-pricing failed: %s", err)
+	}
+
+	return
+}
+
+// GetPricing returns the tariff which applies to resources of the vdc.
+func (v *Vdc) GetPricing(extraArgs ...Arguments) (pricing *Pricing, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	pricing, err = v.manager.GetPricing(args)
+	return
+}
+
+// Estimate returns the hourly cost of the vms with their disks and floating
+// addresses, multiply by HoursPerMonth for a monthly figure. Vms do not have
+// to exist yet, so a whole vdc can be priced before it is created.
+func (p *Pricing) Estimate(vms ...*Vm) (hourly float64, err error) {
+	for _, vm := range vms {
+		hourly += float64(vm.Cpu)*p.Cpu + vm.Ram*p.Ram
+		if vm.Floating != nil {
+			hourly += p.FloatingIp
+		}
+
+		for _, disk := range vm.Disks {
+			price, err := p.storagePrice(disk.StorageProfile)
+			if err != nil {
+				return 0, err
+			}
+			hourly += float64(disk.Size) * price
+		}
+	}
+
+	return
+}
+
+func (p *Pricing) storagePrice(storageProfile *StorageProfile) (float64, error) {
+	if storageProfile == nil {
+		return 0, errors.New("disk without storage profile cannot be priced")
+	}
+
+	for _, storage := range p.Storage {
+		if storage.StorageProfile != nil && storage.StorageProfile.ID == storageProfile.ID {
+			return storage.Price, nil
+		}
+	}
+
+	return 0, errors.Errorf("no price for storage profile '%s'", storageProfile.ID)
+}
@@ -16,6 +16,11 @@ type Project struct {
 	Tags   []Tag `json:"tags"`
 }
 
+type ProjectPatch struct {
+	Name *string   `json:"name,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
 func NewProject(name string) Project {
 	b := Project{Name: name}
 	return b
@@ -94,6 +99,16 @@ func (p *Project) Update() (err error) {
 	return
 }
 
+func (p *Project) Patch(patch ProjectPatch) (err error) {
+	path, _ := url.JoinPath("v1/project", p.ID)
+
+	if err = p.manager.Patch(path, patch, p); err != nil {
+		log.Printf("[REQUEST-ERROR]: patch-project failed: %s", err)
+	}
+
+	return
+}
+
 func (p *Project) Delete() (err error) {
 	path, _ := url.JoinPath("v1/project", p.ID)
 	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
@@ -0,0 +1,55 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type ProjectMember struct {
+	ID   string    `json:"id"`
+	User *MetaData `json:"user"`
+	Role *MetaData `json:"role"`
+}
+
+func (p *Project) GetMembers(extraArgs ...Arguments) (members []*ProjectMember, err error) {
+	path := fmt.Sprintf("v1/project/%s/member", p.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = p.manager.GetItems(path, args, &members); err != nil {
+		log.Printf("[REQUEST-ERROR] get-project-member list failed: %s", err)
+	}
+
+	return
+}
+
+// AddMember gives the user access to the project with the role, a nil role
+// leaves the choice to the platform default.
+func (p *Project) AddMember(user *User, role *Role) (member *ProjectMember, err error) {
+	path := fmt.Sprintf("v1/project/%s/member", p.ID)
+	args := &struct {
+		User string  `json:"user"`
+		Role *string `json:"role,omitempty"`
+	}{
+		User: user.ID,
+	}
+
+	if role != nil {
+		args.Role = &role.ID
+	}
+
+	member = &ProjectMember{}
+	if err = p.manager.Request("POST", path, args, member); err != nil {
+		log.Printf("[REQUEST-ERROR] add-project-member failed: %s", err)
+	}
+
+	return
+}
+
+func (p *Project) RemoveMember(member *ProjectMember) (err error) {
+	path := fmt.Sprintf("v1/project/%s/member/%s", p.ID, member.ID)
+	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] remove-project-member failed: %s", err)
+	}
+	return
+}
@@ -7,12 +7,18 @@ import (
 
 type PubKey struct {
 	manager     *Manager
+	accountId   string
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Fingerprint string `json:"fingerprint"`
 	PublicKey   string `json:"public_key"`
 }
 
+func NewPubKey(name string, publicKey string) PubKey {
+	k := PubKey{Name: name, PublicKey: publicKey}
+	return k
+}
+
 func (m *Manager) GetPublicKeys(accountId string) (publicKeys []*PubKey, err error) {
 	path := fmt.Sprintf("/v1/account/%s/key", accountId)
 
@@ -21,6 +27,7 @@ func (m *Manager) GetPublicKeys(accountId string) (publicKeys []*PubKey, err err
 	} else {
 		for i := range publicKeys {
 			publicKeys[i].manager = m
+			publicKeys[i].accountId = accountId
 		}
 	}
 
@@ -44,7 +51,36 @@ func (m *Manager) GetPublicKey(id string) (publicKey *PubKey, err error) {
 		log.Printf("[REQUEST-ERROR] get-publicKey failed: %s", err)
 	} else {
 		publicKey.manager = m
+		publicKey.accountId = account.ID
+	}
+
+	return
+}
+
+func (a *Account) CreatePublicKey(publicKey *PubKey) (err error) {
+	path := fmt.Sprintf("/v1/account/%s/key", a.ID)
+	args := &struct {
+		Name      string `json:"name"`
+		PublicKey string `json:"public_key"`
+	}{
+		Name:      publicKey.Name,
+		PublicKey: publicKey.PublicKey,
 	}
 
+	if err = a.manager.Request("POST", path, args, &publicKey); err != nil {
+		log.Printf("[REQUEST-ERROR] create-publicKey failed: %s", err)
+	} else {
+		publicKey.manager = a.manager
+		publicKey.accountId = a.ID
+	}
+
+	return
+}
+
+func (p *PubKey) Delete() (err error) {
+	path := fmt.Sprintf("/v1/account/%s/key/%s", p.accountId, p.ID)
+	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-publicKey failed: %s", err)
+	}
 	return
 }
@@ -0,0 +1,43 @@
+package bcc
+
+import "log"
+
+type PublicIpPool struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Cidr       string    `json:"cidr"`
+	IpVersion  int       `json:"ip_version"`
+	DataCenter *MetaData `json:"data_center"`
+	Total      int       `json:"total"`
+	Used       int       `json:"used"`
+	Free       int       `json:"free"`
+}
+
+func (m *Manager) GetPublicIpPools(extraArgs ...Arguments) (pools []*PublicIpPool, err error) {
+	path := "v1/public_ip_pool"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &pools); err != nil {
+		log.Printf("[REQUEST-ERROR] get-public-ip-pool list failed: %s", err)
+	}
+
+	return
+}
+
+func (m *Manager) GetDataCenterPublicIpPools(dataCenterId string, extraArgs ...Arguments) (pools []*PublicIpPool, err error) {
+	args := Arguments{
+		"data_center": dataCenterId,
+	}
+	args.merge(extraArgs)
+	pools, err = m.GetPublicIpPools(args)
+	return
+}
+
+// Utilization returns the used share of the pool between 0 and 1.
+func (p *PublicIpPool) Utilization() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Used) / float64(p.Total)
+}
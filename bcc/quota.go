@@ -0,0 +1,93 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+// QuotaUnlimited lifts the limit of a quota value.
+const QuotaUnlimited = -1
+
+type StorageQuota struct {
+	StorageProfile *MetaData `json:"storage_profile"`
+	// Size is in GB.
+	Size int `json:"size"`
+	Used int `json:"used,omitempty"`
+}
+
+// Quota limits the resources which can be allocated in a project or a vdc.
+// The Used fields are filled in by the platform and ignored on Set.
+type Quota struct {
+	Cpu         int             `json:"cpu"`
+	Ram         int             `json:"ram"`
+	FloatingIps int             `json:"floating_ips"`
+	Networks    int             `json:"networks"`
+	Storage     []*StorageQuota `json:"storage"`
+
+	CpuUsed         int `json:"cpu_used,omitempty"`
+	RamUsed         int `json:"ram_used,omitempty"`
+	FloatingIpsUsed int `json:"floating_ips_used,omitempty"`
+	NetworksUsed    int `json:"networks_used,omitempty"`
+}
+
+func NewStorageQuota(storageProfile *StorageProfile, size int) StorageQuota {
+	q := StorageQuota{StorageProfile: &MetaData{ID: storageProfile.ID, Name: storageProfile.Name}, Size: size}
+	return q
+}
+
+func (p *Project) GetQuota() (*Quota, error) {
+	return p.manager.getQuota(fmt.Sprintf("v1/project/%s/quota", p.ID))
+}
+
+func (p *Project) SetQuota(quota *Quota) error {
+	return p.manager.setQuota(fmt.Sprintf("v1/project/%s/quota", p.ID), quota)
+}
+
+func (v *Vdc) GetQuota() (*Quota, error) {
+	return v.manager.getQuota(fmt.Sprintf("v1/vdc/%s/quota", v.ID))
+}
+
+func (v *Vdc) SetQuota(quota *Quota) error {
+	return v.manager.setQuota(fmt.Sprintf("v1/vdc/%s/quota", v.ID), quota)
+}
+
+func (m *Manager) getQuota(path string) (quota *Quota, err error) {
+	quota = &Quota{}
+
+	if err = m.Get(path, Defaults(), quota); err != nil {
+		log.Printf("[REQUEST-ERROR] get-quota failed: %s", err)
+	}
+
+	return
+}
+
+func (m *Manager) setQuota(path string, quota *Quota) (err error) {
+	type storageQuota struct {
+		StorageProfile string `json:"storage_profile"`
+		Size           int    `json:"size"`
+	}
+
+	args := &struct {
+		Cpu         int            `json:"cpu"`
+		Ram         int            `json:"ram"`
+		FloatingIps int            `json:"floating_ips"`
+		Networks    int            `json:"networks"`
+		Storage     []storageQuota `json:"storage"`
+	}{
+		Cpu:         quota.Cpu,
+		Ram:         quota.Ram,
+		FloatingIps: quota.FloatingIps,
+		Networks:    quota.Networks,
+		Storage:     make([]storageQuota, 0, len(quota.Storage)),
+	}
+
+	for _, storage := range quota.Storage {
+		args.Storage = append(args.Storage, storageQuota{StorageProfile: storage.StorageProfile.ID, Size: storage.Size})
+	}
+
+	if err = m.Request("PUT", path, args, quota); err != nil {
+		log.Printf("[REQUEST-ERROR] set-quota failed: %s", err)
+	}
+
+	return
+}
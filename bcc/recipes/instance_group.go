@@ -0,0 +1,175 @@
+package recipes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/basis-cloud/bcc-go/bcc"
+	"github.com/pkg/errors"
+)
+
+const DefaultInstanceGroupConcurrency = 4
+
+type InstanceGroupSpec struct {
+	// NamePattern is formatted with the index of every vm, e.g. "web-%02d".
+	NamePattern string
+	Count       int
+	StartIndex  int
+	// Concurrency limits how many vms are created at the same time.
+	Concurrency int
+
+	Cpu            int
+	Ram            float64
+	Template       *bcc.Template
+	DiskSize       int
+	StorageProfile *bcc.StorageProfile
+
+	Networks          []*bcc.Network
+	FirewallTemplates []*bcc.FirewallTemplate
+	PublicKeys        []*bcc.PubKey
+	Tags              []bcc.Tag
+}
+
+type InstanceResult struct {
+	Index int
+	Name  string
+	Vm    *bcc.Vm
+	Err   error
+}
+
+type InstanceGroup struct {
+	Results []*InstanceResult
+}
+
+// Vms returns the vms of the group which were created successfully.
+func (g *InstanceGroup) Vms() []*bcc.Vm {
+	vms := make([]*bcc.Vm, 0, len(g.Results))
+	for _, result := range g.Results {
+		if result.Err == nil && result.Vm != nil {
+			vms = append(vms, result.Vm)
+		}
+	}
+	return vms
+}
+
+// CreateInstanceGroup creates spec.Count identical vms in vdc, at most
+// spec.Concurrency at a time. Every vm gets a port in each of spec.Networks.
+// A failed vm does not stop the others; results are ordered by index and an
+// error is returned when any of them failed. The ports of a vm which could
+// not be created are deleted again.
+//
+// The vms are created concurrently through the manager of vdc, so it must
+// not carry a Result set with Manager.WithResult. Requests of the returned
+// vms are bound to ctx as well.
+func CreateInstanceGroup(ctx context.Context, vdc *bcc.Vdc, spec InstanceGroupSpec) (group *InstanceGroup, err error) {
+	concurrency := spec.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultInstanceGroupConcurrency
+	}
+
+	group = &InstanceGroup{Results: make([]*InstanceResult, spec.Count)}
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < spec.Count; i++ {
+		index := spec.StartIndex + i
+		result := &InstanceResult{Index: index, Name: fmt.Sprintf(spec.NamePattern, index)}
+		group.Results[i] = result
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			result.Vm, result.Err = createInstance(ctx, vdc, spec, result.Name)
+		}()
+	}
+
+	wg.Wait()
+
+	failed := 0
+	for _, result := range group.Results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		err = errors.Errorf("instance group: %d of %d vms failed", failed, spec.Count)
+	}
+
+	return
+}
+
+func createInstance(ctx context.Context, vdc *bcc.Vdc, spec InstanceGroupSpec, name string) (vm *bcc.Vm, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// ports are created without the cancellation of ctx, so they can still
+	// be deleted once ctx is done
+	portVdc := vdc.WithContext(context.WithoutCancel(ctx))
+	ports := make([]*bcc.Port, 0, len(spec.Networks))
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, port := range ports {
+			if deleteErr := port.Delete(); deleteErr != nil {
+				err = errors.Wrapf(err, "delete port %s of %s: %s", port.ID, name, deleteErr)
+			}
+		}
+	}()
+
+	// the responses are decoded into the objects of the request, so every
+	// instance gets references of its own instead of the shared ones of spec
+	firewallTemplates := func() []*bcc.FirewallTemplate {
+		templates := make([]*bcc.FirewallTemplate, len(spec.FirewallTemplates))
+		for i, template := range spec.FirewallTemplates {
+			templates[i] = &bcc.FirewallTemplate{ID: template.ID}
+		}
+		return templates
+	}
+
+	for _, network := range spec.Networks {
+		port := &bcc.Port{Network: &bcc.Network{ID: network.ID}, FirewallTemplates: firewallTemplates(), Tags: slices.Clone(spec.Tags)}
+		if err = portVdc.CreateEmptyPort(port); err != nil {
+			return nil, errors.Wrapf(err, "create port for %s", name)
+		}
+		ports = append(ports, port)
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	var storageProfile *bcc.StorageProfile
+	if spec.StorageProfile != nil {
+		storageProfile = &bcc.StorageProfile{ID: spec.StorageProfile.ID}
+	}
+	var template *bcc.Template
+	if spec.Template != nil {
+		template = &bcc.Template{ID: spec.Template.ID}
+	}
+	publicKeys := make([]*bcc.PubKey, len(spec.PublicKeys))
+	for i, publicKey := range spec.PublicKeys {
+		publicKeys[i] = &bcc.PubKey{ID: publicKey.ID}
+	}
+
+	disk := bcc.NewDisk(fmt.Sprintf("%s-root", name), spec.DiskSize, storageProfile)
+	newVm := bcc.NewVm(name, spec.Cpu, spec.Ram, template, nil, nil, slices.Clone(ports), []*bcc.Disk{&disk}, nil)
+	newVm.PublicKeys = publicKeys
+	newVm.Tags = slices.Clone(spec.Tags)
+	if err = vdc.WithContext(ctx).CreateVm(&newVm); err != nil {
+		return nil, errors.Wrapf(err, "create vm %s", name)
+	}
+
+	return &newVm, nil
+}
@@ -0,0 +1,107 @@
+package recipes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/basis-cloud/bcc-go/bcc"
+)
+
+// instanceGroupServer answers the requests of CreateInstanceGroup with full
+// objects, so the responses are decoded into every reference of a request.
+func instanceGroupServer(t *testing.T) *httptest.Server {
+	var ids atomic.Int64
+	nextId := func(kind string) string {
+		return fmt.Sprintf("%s-%d", kind, ids.Add(1))
+	}
+
+	network := `{"id": "net-1", "name": "net", "subnets": [{"id": "subnet-1", "cidr": "10.0.0.0/24"}], "tags": [{"id": "tag-1", "name": "web"}]}`
+	firewall := `{"id": "fw-1", "name": "web", "tags": [{"id": "tag-1", "name": "web"}]}`
+	tags := `[{"id": "tag-1", "name": "web"}]`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/vdc/vdc-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "vdc-1", "name": "vdc"}`)
+	})
+	mux.HandleFunc("/v1/port", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": %q, "network": %s, "fw_templates": [%s], "tags": %s}`, nextId("port"), network, firewall, tags)
+	})
+	mux.HandleFunc("/v1/vm", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"id": %q,
+			"template": {"id": "tpl-1", "name": "ubuntu", "min_cpu": 1},
+			"ports": [{"id": %q, "network": %s, "fw_templates": [%s], "tags": %s}],
+			"disks": [{"id": %q, "name": "root", "storage_profile": {"id": "sp-1", "name": "ssd"}}],
+			"public_keys": [{"id": "key-1", "name": "admin"}],
+			"tags": %s
+		}`, nextId("vm"), nextId("port"), network, firewall, tags, nextId("disk"), tags)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestCreateInstanceGroupSharedSpec(t *testing.T) {
+	server := instanceGroupServer(t)
+	defer server.Close()
+
+	manager, err := bcc.NewManager("token", "", "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager.BaseURL = server.URL
+	manager.RequestTimeout = 5 * time.Second
+	manager.RequestInterval = 10 * time.Millisecond
+
+	vdc, err := manager.GetVdc("vdc-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	network := &bcc.Network{ID: "net-1"}
+	spec := InstanceGroupSpec{
+		NamePattern:       "web-%02d",
+		Count:             8,
+		Concurrency:       8,
+		Cpu:               1,
+		Ram:               1,
+		Template:          &bcc.Template{ID: "tpl-1"},
+		DiskSize:          10,
+		StorageProfile:    &bcc.StorageProfile{ID: "sp-1"},
+		Networks:          []*bcc.Network{network},
+		FirewallTemplates: []*bcc.FirewallTemplate{{ID: "fw-1"}},
+		PublicKeys:        []*bcc.PubKey{{ID: "key-1"}},
+		Tags:              []bcc.Tag{{Name: "web"}},
+	}
+
+	group, err := CreateInstanceGroup(context.Background(), vdc, spec)
+	if err != nil {
+		for _, result := range group.Results {
+			if result.Err != nil {
+				t.Errorf("%s: %s", result.Name, result.Err)
+			}
+		}
+		t.Fatal(err)
+	}
+
+	if vms := group.Vms(); len(vms) != spec.Count {
+		t.Fatalf("got %d vms, want %d", len(vms), spec.Count)
+	}
+	if network.Name != "" || network.Subnets != nil {
+		t.Errorf("network of the spec was overwritten: %+v", network)
+	}
+	if spec.Template.Name != "" || spec.StorageProfile.Name != "" || spec.PublicKeys[0].Name != "" || spec.FirewallTemplates[0].Name != "" {
+		t.Errorf("references of the spec were overwritten")
+	}
+	if spec.Tags[0].ID != "" {
+		t.Errorf("tags of the spec were overwritten: %+v", spec.Tags)
+	}
+}
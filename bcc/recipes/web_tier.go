@@ -0,0 +1,185 @@
+// Package recipes composes bcc resources into common topologies.
+package recipes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/basis-cloud/bcc-go/bcc"
+	"github.com/pkg/errors"
+)
+
+// RandomFloating asks the platform to allocate any free floating address.
+const RandomFloating = "RANDOM_FIP"
+
+type WebTierSpec struct {
+	Name           string
+	Count          int
+	Cpu            int
+	Ram            float64
+	Template       *bcc.Template
+	DiskSize       int
+	StorageProfile *bcc.StorageProfile
+
+	Cidr       string
+	Gateway    string
+	StartIp    string
+	EndIp      string
+	DnsServers []string
+
+	Port     int
+	Floating bool
+	Tags     []bcc.Tag
+}
+
+// WebTier holds the resources of a web tier. Ports has the port of every vm,
+// also of a vm which failed to create.
+type WebTier struct {
+	Network      *bcc.Network
+	Subnet       *bcc.Subnet
+	Firewall     *bcc.FirewallTemplate
+	Ports        []*bcc.Port
+	Vms          []*bcc.Vm
+	LoadBalancer *bcc.LoadBalancer
+	Pool         *bcc.LoadBalancerPool
+}
+
+// BootstrapWebTier creates a network, a firewall template which lets HTTP(S)
+// in, spec.Count identical VMs and a load balancer in front of them. All
+// requests are bound to ctx, so cancelling it stops a blocking call as well.
+//
+// Nothing is removed on failure: the resources created so far are returned
+// in tier and are left for the caller to inspect or delete. They are bound
+// to ctx too, so once ctx is done fetch them again through a vdc with a
+// live context, e.g. vdc.GetVms, before deleting them.
+func BootstrapWebTier(ctx context.Context, vdc *bcc.Vdc, spec WebTierSpec) (tier *WebTier, err error) {
+	tier = &WebTier{}
+	vdc = vdc.WithContext(ctx)
+
+	if spec.Count <= 0 {
+		err = errors.Errorf("bootstrap web tier: count must be positive, got %d", spec.Count)
+		return
+	}
+
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	if spec.Port == 0 {
+		spec.Port = 80
+	}
+
+	network := bcc.NewNetwork(fmt.Sprintf("%s-net", spec.Name))
+	network.Tags = spec.Tags
+	if err = step("create network", vdc.CreateNetwork(&network)); err != nil {
+		return
+	}
+	tier.Network = &network
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	subnet := bcc.NewSubnet(spec.Cidr, spec.Gateway, spec.StartIp, spec.EndIp, true)
+	for _, dnsServer := range spec.DnsServers {
+		server := bcc.NewSubnetDNSServer(dnsServer)
+		subnet.DnsServers = append(subnet.DnsServers, &server)
+	}
+	if err = step("create subnet", network.CreateSubnet(&subnet)); err != nil {
+		return
+	}
+	tier.Subnet = &subnet
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	firewall := bcc.NewFirewallTemplate(fmt.Sprintf("%s-web", spec.Name))
+	firewall.Tags = spec.Tags
+	if err = step("create firewall template", vdc.CreateFirewallTemplate(&firewall)); err != nil {
+		return
+	}
+	tier.Firewall = &firewall
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	rules := []bcc.FirewallRule{
+		bcc.NewFirewallRule("http", "0.0.0.0/0", "ingress", "tcp", spec.Port, spec.Port),
+		bcc.NewFirewallRule("https", "0.0.0.0/0", "ingress", "tcp", 443, 443),
+		bcc.NewFirewallRule("egress", "0.0.0.0/0", "egress", "any", 0, 0),
+	}
+	for i := range rules {
+		if err = step("create firewall rule", firewall.CreateFirewallRule(&rules[i])); err != nil {
+			return
+		}
+		if err = ctx.Err(); err != nil {
+			return
+		}
+	}
+
+	members := make([]*bcc.PoolMember, 0, spec.Count)
+	for i := 1; i <= spec.Count; i++ {
+		name := fmt.Sprintf("%s-%d", spec.Name, i)
+
+		port := bcc.Port{Network: &network, FirewallTemplates: []*bcc.FirewallTemplate{&firewall}}
+		if err = step("create port for "+name, vdc.CreateEmptyPort(&port)); err != nil {
+			return
+		}
+		tier.Ports = append(tier.Ports, &port)
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		disk := bcc.NewDisk(fmt.Sprintf("%s-root", name), spec.DiskSize, spec.StorageProfile)
+		vm := bcc.NewVm(name, spec.Cpu, spec.Ram, spec.Template, nil, nil, []*bcc.Port{&port}, []*bcc.Disk{&disk}, nil)
+		vm.Tags = spec.Tags
+		if err = step("create vm "+name, vdc.CreateVm(&vm)); err != nil {
+			return
+		}
+		tier.Vms = append(tier.Vms, &vm)
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		member := bcc.NewLoadBalancerPoolMember(spec.Port, 1, &bcc.TmpVm{ID: vm.ID})
+		members = append(members, &member)
+	}
+
+	var floating *bcc.Port
+	if spec.Floating {
+		address := RandomFloating
+		floating = &bcc.Port{IpAddress: &address}
+	}
+
+	lb := bcc.NewLoadBalancer(fmt.Sprintf("%s-lb", spec.Name), vdc, &bcc.Port{Network: &network}, floating)
+	lb.Tags = spec.Tags
+	if err = step("create load balancer", vdc.CreateLoadBalancer(&lb)); err != nil {
+		return
+	}
+	tier.LoadBalancer = &lb
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	if err = step("wait load balancer", lb.WaitLock()); err != nil {
+		return
+	}
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	pool := bcc.NewLoadBalancerPool(lb, spec.Port, 0, members, "ROUND_ROBIN", "TCP", "", nil)
+	if err = step("create load balancer pool", lb.CreatePool(&pool)); err != nil {
+		return
+	}
+	tier.Pool = &pool
+
+	return
+}
+
+func step(name string, err error) error {
+	if err != nil {
+		return errors.Wrapf(err, "bootstrap web tier: %s", name)
+	}
+
+	return nil
+}
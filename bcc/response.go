@@ -0,0 +1,99 @@
+package bcc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func (r *Response) Decode(target interface{}) error {
+	return json.Unmarshal(r.Body, target)
+}
+
+// Do calls an arbitrary endpoint with the manager credentials. Locked objects
+// are retried and tasks are awaited like in Request, but non 2xx responses are
+// returned as is instead of as an error. body may be nil, raw []byte or any
+// value which is encoded as JSON.
+func (m *Manager) Do(ctx context.Context, method string, path string, body interface{}) (*Response, error) {
+	m.log("[bcc] %s %s", method, path)
+
+	var requestBody []byte
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		requestBody = b
+	default:
+		res, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		requestBody = res
+	}
+
+	requestUrl, _ := url.JoinPath(m.BaseURL, path)
+	if parsed, err := url.Parse(path); err == nil && parsed.RawQuery != "" {
+		requestUrl, _ = url.JoinPath(m.BaseURL, parsed.Path)
+		requestUrl = fmt.Sprintf("%s?%s", requestUrl, parsed.RawQuery)
+	}
+
+	var reader io.Reader
+	if requestBody != nil {
+		reader = bytes.NewReader(requestBody)
+	}
+
+	req, err := http.NewRequest(method, requestUrl, reader)
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] Invalid %s request %s", method, requestUrl)
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.Token))
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req = req.WithContext(ctx)
+
+	if m.skipDryRun(req, requestBody) {
+		return &Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil
+	}
+
+	manager := m.WithContext(ctx)
+	resp, err := manager.send(req, requestUrl, requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "HTTP Read error on response for %s", requestUrl)
+	}
+
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       b,
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		if err = manager.waitTasks(resp.Header.Get("X-Esu-Tasks")); err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,69 @@
+package bcc
+
+import (
+	"net/http"
+	"time"
+)
+
+// Result describes the last HTTP exchange performed by a manager created
+// with WithResult. Paginated calls also report the number of pages, the
+// effective page size used by the endpoint and the total number of items,
+// URL and status are the ones of the first page and Duration covers all of
+// them. Polling of tasks started by the request is not recorded.
+type Result struct {
+	Method     string
+	URL        string
+	Arguments  Arguments
+	Body       []byte
+	StatusCode int
+	Header     http.Header
+	Duration   time.Duration
+	Retries    int
+	Pages      int
+	PageSize   int
+	Total      int
+}
+
+func (m *Manager) WithResult(result *Result) *Manager {
+	newManager := *m
+	newManager.result = result
+	return &newManager
+}
+
+// withoutResult returns a copy of the manager for follow-up requests which
+// must not replace the recorded result of the call that caused them.
+func (m *Manager) withoutResult() *Manager {
+	if m.result == nil {
+		return m
+	}
+	newManager := *m
+	newManager.result = nil
+	return &newManager
+}
+
+func (m *Manager) recordResult(req *http.Request, requestBody []byte, resp *http.Response, start time.Time, retries int) {
+	if m.result == nil {
+		return
+	}
+
+	args := Defaults()
+	for key, values := range req.URL.Query() {
+		if len(values) > 0 {
+			args[key] = values[0]
+		}
+	}
+
+	*m.result = Result{
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Arguments: args,
+		Body:      requestBody,
+		Duration:  time.Since(start),
+		Retries:   retries,
+	}
+
+	if resp != nil {
+		m.result.StatusCode = resp.StatusCode
+		m.result.Header = resp.Header
+	}
+}
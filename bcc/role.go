@@ -0,0 +1,113 @@
+package bcc
+
+import (
+	"log"
+	"net/url"
+)
+
+const (
+	RoleScopeClient  = "client"
+	RoleScopeProject = "project"
+	RoleScopeVdc     = "vdc"
+)
+
+type Role struct {
+	manager     *Manager
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Scope       string   `json:"scope"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleAssignment grants the role to the user on either a project or a vdc.
+type RoleAssignment struct {
+	manager *Manager
+	ID      string    `json:"id"`
+	User    *MetaData `json:"user"`
+	Role    *MetaData `json:"role"`
+	Project *MetaData `json:"project,omitempty"`
+	Vdc     *MetaData `json:"vdc,omitempty"`
+}
+
+func (m *Manager) GetRoles(extraArgs ...Arguments) (roles []*Role, err error) {
+	path := "v1/role"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &roles); err != nil {
+		log.Printf("[REQUEST-ERROR] get-role list failed: %s", err)
+	} else {
+		for i := range roles {
+			roles[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (m *Manager) GetRoleAssignments(extraArgs ...Arguments) (assignments []*RoleAssignment, err error) {
+	path := "v1/role_assignment"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &assignments); err != nil {
+		log.Printf("[REQUEST-ERROR] get-role-assignment list failed: %s", err)
+	} else {
+		for i := range assignments {
+			assignments[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (u *User) GetRoleAssignments(extraArgs ...Arguments) (assignments []*RoleAssignment, err error) {
+	args := Arguments{
+		"user": u.ID,
+	}
+	args.merge(extraArgs)
+	assignments, err = u.manager.GetRoleAssignments(args)
+	return
+}
+
+func (r *Role) AssignOnProject(user *User, project *Project) (*RoleAssignment, error) {
+	return r.assign(user, &project.ID, nil)
+}
+
+func (r *Role) AssignOnVdc(user *User, vdc *Vdc) (*RoleAssignment, error) {
+	return r.assign(user, nil, &vdc.ID)
+}
+
+func (r *Role) assign(user *User, projectId *string, vdcId *string) (assignment *RoleAssignment, err error) {
+	path := "v1/role_assignment"
+	args := &struct {
+		Role    string  `json:"role"`
+		User    string  `json:"user"`
+		Project *string `json:"project,omitempty"`
+		Vdc     *string `json:"vdc,omitempty"`
+	}{
+		Role:    r.ID,
+		User:    user.ID,
+		Project: projectId,
+		Vdc:     vdcId,
+	}
+
+	assignment = &RoleAssignment{}
+	if err = r.manager.Request("POST", path, args, assignment); err != nil {
+		log.Printf("[REQUEST-ERROR] create-role-assignment failed: %s", err)
+	} else {
+		assignment.manager = r.manager
+	}
+
+	return
+}
+
+// Delete revokes the role from the user on the scope of the assignment.
+func (a *RoleAssignment) Delete() (err error) {
+	path, _ := url.JoinPath("v1/role_assignment", a.ID)
+	if err = a.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-role-assignment failed: %s", err)
+	}
+	return
+}
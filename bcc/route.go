@@ -20,6 +20,22 @@ func NewRoute(destination, nexthop string) Route {
 	}
 }
 
+func (r *Router) GetRoutes(extraArgs ...Arguments) (routes []*Route, err error) {
+	path, _ := url.JoinPath("v1/router", r.ID, "route")
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = r.manager.GetItems(path, args, &routes); err != nil {
+		log.Printf("[REQUEST-ERROR]: get-route list failed: %s", err)
+	} else {
+		for i := range routes {
+			routes[i].router = r
+		}
+	}
+
+	return
+}
+
 func (r *Router) GetRoute(id string) (route *Route, err error) {
 	path, _ := url.JoinPath("v1/router", r.ID, "route", id)
 
@@ -51,6 +67,33 @@ func (r *Router) CreateRoute(route *Route) (err error) {
 	return
 }
 
+// AddRoute creates a static route to the destination cidr via nexthop.
+func (r *Router) AddRoute(destination string, nexthop string) (route *Route, err error) {
+	newRoute := NewRoute(destination, nexthop)
+	route = &newRoute
+
+	if err = r.CreateRoute(route); err == nil {
+		r.Routes = append(r.Routes, route)
+	}
+
+	return
+}
+
+func (r *Router) DeleteRoute(route *Route) (err error) {
+	if err = route.Delete(); err != nil {
+		return
+	}
+
+	for i, routerRoute := range r.Routes {
+		if routerRoute.ID == route.ID {
+			r.Routes = append(r.Routes[:i], r.Routes[i+1:]...)
+			break
+		}
+	}
+
+	return
+}
+
 func (route *Route) Update() (err error) {
 	path, _ := url.JoinPath("v1/router", route.router.ID, "route", route.ID)
 	args := &struct {
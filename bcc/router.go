@@ -8,15 +8,16 @@ import (
 
 type Router struct {
 	manager   *Manager
-	ID        string   `json:"id"`
-	Name      string   `json:"name"`
-	IsDefault bool     `json:"is_default"`
-	Vdc       *Vdc     `json:"vdc"`
-	Ports     []*Port  `json:"ports"`
-	Routes    []*Route `json:"routes"`
-	Floating  *Port    `json:"floating"`
-	Locked    bool     `json:"locked"`
-	Tags      []Tag    `json:"tags"`
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	IsDefault bool        `json:"is_default"`
+	Vdc       *Vdc        `json:"vdc"`
+	Ports     []*Port     `json:"ports"`
+	Routes    []*Route    `json:"routes"`
+	Floating  *Port       `json:"floating"`
+	Locked    bool        `json:"locked"`
+	Tags      []Tag       `json:"tags"`
+	Snat      *RouterSnat `json:"snat,omitempty"`
 }
 
 func NewRouter(name string, floating *string, vdc string) Router {
@@ -36,13 +37,7 @@ func (m *Manager) GetRouters(extraArgs ...Arguments) (routers []*Router, err err
 		log.Printf("[REQUEST-ERROR]: get-router list failed: %s", err)
 	} else {
 		for i := range routers {
-			routers[i].manager = m
-			for x := range routers[i].Ports {
-				routers[i].Ports[x].manager = m
-			}
-			for x := range routers[i].Routes {
-				routers[i].Routes[x].router = routers[i]
-			}
+			routers[i].setManager(m)
 		}
 	}
 
@@ -64,13 +59,7 @@ func (m *Manager) GetRouter(id string) (router *Router, err error) {
 	if err = m.Get(path, Defaults(), &router); err != nil {
 		log.Printf("[REQUEST-ERROR]: get-router with id='%s' failed: %s", id, err)
 	} else {
-		router.manager = m
-		for _, port := range router.Ports {
-			port.manager = m
-		}
-		for _, route := range router.Routes {
-			route.router = router
-		}
+		router.setManager(m)
 	}
 
 	return
@@ -114,7 +103,7 @@ func (v *Vdc) CreateRouter(router *Router) (err error) {
 	if err = v.manager.Request("POST", path, args, &router); err != nil {
 		log.Printf("[REQUEST-ERROR]: create-router failed: %s", err)
 	} else {
-		router.manager = v.manager
+		router.setManager(v.manager)
 	}
 
 	return
@@ -127,6 +116,7 @@ func (r *Router) ConnectPort(port *Port, exsist bool) (err error) {
 		Router      string   `json:"router"`
 		Network     string   `json:"network"`
 		IpAddress   *string  `json:"ip_address,omitempty"`
+		Ipv6Address *string  `json:"ipv6_address,omitempty"`
 		FwTemplates []string `json:"fw_templates"`
 	}
 
@@ -138,6 +128,7 @@ func (r *Router) ConnectPort(port *Port, exsist bool) (err error) {
 		Router:      r.ID,
 		Network:     port.Network.ID,
 		IpAddress:   port.IpAddress,
+		Ipv6Address: port.Ipv6Address,
 		FwTemplates: fwTemplates,
 	}
 
@@ -158,7 +149,7 @@ func (r *Router) ConnectPort(port *Port, exsist bool) (err error) {
 func (r *Router) DisconnectPort(port *Port) (err error) {
 	path := fmt.Sprintf("v1/port/%s/disconnect", port.ID)
 
-	if err := r.manager.Request("PATCH", path, Defaults(), &port); err != nil {
+	if err = r.manager.Request("PATCH", path, Defaults(), &port); err != nil {
 		log.Printf("[REQUEST-ERROR]: disconnect-port failed: %s", err)
 	} else {
 		for i, routerPorts := range r.Ports {
@@ -181,8 +172,51 @@ func (r *Router) Delete() (err error) {
 }
 
 func (r *Router) Rename(name string) error {
+	r.Name = name
+	return r.Update()
+}
+
+// GetNetworks returns the networks the router is connected to through its
+// ports.
+func (r *Router) GetNetworks() (networks []*Network, err error) {
+	if err = r.Reload(); err != nil {
+		return
+	}
+
+	seen := make(map[string]bool, len(r.Ports))
+	for _, port := range r.Ports {
+		if port.Network == nil || seen[port.Network.ID] {
+			continue
+		}
+		seen[port.Network.ID] = true
+		port.Network.manager = r.manager
+		networks = append(networks, port.Network)
+	}
+
+	return
+}
+
+func (r *Router) Reload() (err error) {
 	path, _ := url.JoinPath("v1/router", r.ID)
-	return r.manager.Request("PUT", path, Arguments{"name": name}, r.ID)
+	m := r.manager
+
+	if err = m.Get(path, Defaults(), &r); err != nil {
+		log.Printf("[REQUEST-ERROR]: get-router with id='%s' failed: %s", r.ID, err)
+	} else {
+		r.setManager(m)
+	}
+
+	return
+}
+
+func (r *Router) setManager(m *Manager) {
+	r.manager = m
+	for _, port := range r.Ports {
+		port.manager = m
+	}
+	for _, route := range r.Routes {
+		route.router = r
+	}
 }
 
 func (r *Router) Update() (err error) {
@@ -205,10 +239,12 @@ func (r *Router) Update() (err error) {
 		Routes:    r.Routes,
 		Tags:      convertTagsToNames(r.Tags),
 	}
-	if r.Floating == nil {
-		args.Floating = nil
-	} else {
-		args.Floating = &r.Floating.ID
+	if r.Floating != nil {
+		if r.Floating.ID != "" {
+			args.Floating = &r.Floating.ID
+		} else {
+			args.Floating = r.Floating.IpAddress
+		}
 	}
 
 	if err := r.WaitLock(); err != nil {
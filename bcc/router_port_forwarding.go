@@ -0,0 +1,127 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type PortForwarding struct {
+	manager      *Manager
+	routerId     string
+	ID           string `json:"id"`
+	Protocol     string `json:"protocol"`
+	ExternalPort int    `json:"external_port"`
+	InternalIp   string `json:"internal_ip"`
+	InternalPort int    `json:"internal_port"`
+	Description  string `json:"description,omitempty"`
+	Locked       bool   `json:"locked"`
+}
+
+func NewPortForwarding(protocol string, externalPort int, internalIp string, internalPort int) PortForwarding {
+	p := PortForwarding{Protocol: protocol, ExternalPort: externalPort, InternalIp: internalIp, InternalPort: internalPort}
+	return p
+}
+
+func (r *Router) GetPortForwardings(extraArgs ...Arguments) (portForwardings []*PortForwarding, err error) {
+	path := fmt.Sprintf("v1/router/%s/port_forwarding", r.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = r.manager.GetItems(path, args, &portForwardings); err != nil {
+		log.Printf("[REQUEST-ERROR] get-port-forwarding list failed: %s", err)
+	} else {
+		for i := range portForwardings {
+			portForwardings[i].manager = r.manager
+			portForwardings[i].routerId = r.ID
+		}
+	}
+
+	return
+}
+
+func (r *Router) CreatePortForwarding(portForwarding *PortForwarding) (err error) {
+	path := fmt.Sprintf("v1/router/%s/port_forwarding", r.ID)
+
+	if err = r.manager.Request("POST", path, portForwarding, &portForwarding); err != nil {
+		log.Printf("[REQUEST-ERROR] create-port-forwarding failed: %s", err)
+	} else {
+		portForwarding.manager = r.manager
+		portForwarding.routerId = r.ID
+	}
+
+	return
+}
+
+// ReplacePortForwardings makes the rules of the router match portForwardings.
+// Rules are matched by protocol and external port: matching rules are
+// updated in place, missing ones are created and the rest are deleted.
+func (r *Router) ReplacePortForwardings(portForwardings []*PortForwarding) (err error) {
+	existing, err := r.GetPortForwardings()
+	if err != nil {
+		return
+	}
+
+	byKey := make(map[string]*PortForwarding, len(existing))
+	for _, portForwarding := range existing {
+		byKey[portForwarding.key()] = portForwarding
+	}
+
+	for _, portForwarding := range portForwardings {
+		current, ok := byKey[portForwarding.key()]
+		if !ok {
+			if err = r.CreatePortForwarding(portForwarding); err != nil {
+				return
+			}
+			continue
+		}
+		delete(byKey, portForwarding.key())
+
+		if current.InternalIp == portForwarding.InternalIp &&
+			current.InternalPort == portForwarding.InternalPort &&
+			current.Description == portForwarding.Description {
+			continue
+		}
+
+		current.InternalIp = portForwarding.InternalIp
+		current.InternalPort = portForwarding.InternalPort
+		current.Description = portForwarding.Description
+		if err = current.Update(); err != nil {
+			return
+		}
+	}
+
+	for _, portForwarding := range byKey {
+		if err = portForwarding.Delete(); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (p *PortForwarding) key() string {
+	return fmt.Sprintf("%s/%d", p.Protocol, p.ExternalPort)
+}
+
+func (p *PortForwarding) Update() (err error) {
+	path := fmt.Sprintf("v1/router/%s/port_forwarding/%s", p.routerId, p.ID)
+
+	if err = p.manager.Request("PUT", path, p, &p); err != nil {
+		log.Printf("[REQUEST-ERROR] update-port-forwarding failed: %s", err)
+	}
+
+	return
+}
+
+func (p *PortForwarding) Delete() (err error) {
+	path := fmt.Sprintf("v1/router/%s/port_forwarding/%s", p.routerId, p.ID)
+	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-port-forwarding failed: %s", err)
+	}
+	return
+}
+
+func (p PortForwarding) WaitLock() (err error) {
+	path := fmt.Sprintf("v1/router/%s/port_forwarding/%s", p.routerId, p.ID)
+	return loopWaitLock(p.manager, path)
+}
@@ -0,0 +1,39 @@
+package bcc
+
+import (
+	"log"
+	"net/url"
+)
+
+type RouterSnat struct {
+	Enabled bool `json:"enabled"`
+	// SourceIp is the external address used for translated traffic, the
+	// router floating address when empty.
+	SourceIp string `json:"source_ip,omitempty"`
+}
+
+func (r *Router) EnableSnat() error {
+	return r.SetSnat(RouterSnat{Enabled: true})
+}
+
+func (r *Router) DisableSnat() error {
+	return r.SetSnat(RouterSnat{Enabled: false})
+}
+
+func (r *Router) SetSnat(snat RouterSnat) (err error) {
+	path, _ := url.JoinPath("v1/router", r.ID)
+	m := r.manager
+	args := &struct {
+		Snat RouterSnat `json:"snat"`
+	}{
+		Snat: snat,
+	}
+
+	if err = m.Patch(path, args, r); err != nil {
+		log.Printf("[REQUEST-ERROR]: set-router-snat failed: %s", err)
+	} else {
+		r.setManager(m)
+	}
+
+	return
+}
@@ -116,7 +116,7 @@ func (s3 *S3Storage) Update() (err error) {
 	if err = s3.manager.Request("PUT", path, args, s3); err != nil {
 		log.Printf("[REQUEST-ERROR] update-s3Storage failed: %s", err)
 	} else {
-		s3.WaitLock()
+		err = s3.WaitLock()
 	}
 
 	return
@@ -158,6 +158,7 @@ func (m *Manager) GetBuckets(id string, extraArgs ...Arguments) (buckets []*S3St
 	} else {
 		for i := range buckets {
 			buckets[i].manager = m
+			buckets[i].S3StorageId = id
 		}
 	}
 
@@ -169,6 +170,16 @@ func (s3 *S3Storage) GetBuckets(extraArgs ...Arguments) (buckets []*S3StorageBuc
 	return
 }
 
+// BucketURL returns the path-style url of the bucket on the storage endpoint.
+func (s3 *S3Storage) BucketURL(bucket *S3StorageBucket) string {
+	name := bucket.ExternalName
+	if name == "" {
+		name = bucket.Name
+	}
+	bucketUrl, _ := url.JoinPath(s3.ClientEndpoint, name)
+	return bucketUrl
+}
+
 func (s3 *S3Storage) GetBucket(id string) (bucket *S3StorageBucket, err error) {
 	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s", s3.ID, id)
 
@@ -0,0 +1,80 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type S3AccessKey struct {
+	manager     *Manager
+	s3StorageId string
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	AccessKey   string `json:"access_key"`
+	// SecretKey is only returned when the key is created or rotated.
+	SecretKey string `json:"secret_key,omitempty"`
+	Enabled   bool   `json:"enabled"`
+	CreatedAt Time   `json:"created_at"`
+	LastUsed  *Time  `json:"last_used,omitempty"`
+}
+
+func NewS3AccessKey(description string) S3AccessKey {
+	k := S3AccessKey{Description: description}
+	return k
+}
+
+func (s3 *S3Storage) GetAccessKeys(extraArgs ...Arguments) (keys []*S3AccessKey, err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/key", s3.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = s3.manager.GetItems(path, args, &keys); err != nil {
+		log.Printf("[REQUEST-ERROR] get-s3-access-key list failed: %s", err)
+	} else {
+		for i := range keys {
+			keys[i].manager = s3.manager
+			keys[i].s3StorageId = s3.ID
+		}
+	}
+
+	return
+}
+
+func (s3 *S3Storage) CreateAccessKey(key *S3AccessKey) (err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/key", s3.ID)
+	args := &struct {
+		Description string `json:"description"`
+	}{
+		Description: key.Description,
+	}
+
+	if err = s3.manager.Request("POST", path, args, &key); err != nil {
+		log.Printf("[REQUEST-ERROR] create-s3-access-key failed: %s", err)
+	} else {
+		key.manager = s3.manager
+		key.s3StorageId = s3.ID
+	}
+
+	return
+}
+
+// Rotate issues a new key pair in place of the current one. The old pair
+// stops working as soon as the call returns.
+func (k *S3AccessKey) Rotate() (err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/key/%s/rotate", k.s3StorageId, k.ID)
+
+	if err = k.manager.Request("POST", path, nil, k); err != nil {
+		log.Printf("[REQUEST-ERROR] rotate-s3-access-key failed: %s", err)
+	}
+
+	return
+}
+
+// Revoke deletes the key pair.
+func (k *S3AccessKey) Revoke() (err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/key/%s", k.s3StorageId, k.ID)
+	if err = k.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] revoke-s3-access-key failed: %s", err)
+	}
+	return
+}
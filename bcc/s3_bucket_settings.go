@@ -0,0 +1,118 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type S3BucketQuota struct {
+	// MaxSize is in GB, 0 means unlimited.
+	MaxSize int `json:"max_size"`
+	// MaxObjects is 0 when the amount of objects is unlimited.
+	MaxObjects int `json:"max_objects"`
+}
+
+type S3BucketLifecycleRule struct {
+	ID      string `json:"id"`
+	Prefix  string `json:"prefix"`
+	Enabled bool   `json:"enabled"`
+	// ExpirationDays removes current object versions after the given days.
+	ExpirationDays int `json:"expiration_days,omitempty"`
+	// NoncurrentExpirationDays removes old object versions of a versioned
+	// bucket after the given days.
+	NoncurrentExpirationDays int `json:"noncurrent_expiration_days,omitempty"`
+}
+
+func NewS3BucketQuota(maxSize int, maxObjects int) S3BucketQuota {
+	q := S3BucketQuota{MaxSize: maxSize, MaxObjects: maxObjects}
+	return q
+}
+
+func NewS3BucketLifecycleRule(id string, prefix string, expirationDays int) S3BucketLifecycleRule {
+	r := S3BucketLifecycleRule{ID: id, Prefix: prefix, Enabled: true, ExpirationDays: expirationDays}
+	return r
+}
+
+func (b *S3StorageBucket) GetQuota() (quota *S3BucketQuota, err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s/quota", b.S3StorageId, b.ID)
+	quota = &S3BucketQuota{}
+
+	if err = b.manager.Get(path, Defaults(), quota); err != nil {
+		log.Printf("[REQUEST-ERROR] get-bucket-quota failed: %s", err)
+	}
+
+	return
+}
+
+func (b *S3StorageBucket) SetQuota(quota *S3BucketQuota) (err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s/quota", b.S3StorageId, b.ID)
+
+	if err = b.manager.Request("PUT", path, quota, quota); err != nil {
+		log.Printf("[REQUEST-ERROR] set-bucket-quota failed: %s", err)
+	}
+
+	return
+}
+
+func (b *S3StorageBucket) GetVersioning() (enabled bool, err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s/versioning", b.S3StorageId, b.ID)
+	target := &struct {
+		Enabled bool `json:"enabled"`
+	}{}
+
+	if err = b.manager.Get(path, Defaults(), target); err != nil {
+		log.Printf("[REQUEST-ERROR] get-bucket-versioning failed: %s", err)
+	}
+
+	return target.Enabled, err
+}
+
+// SetVersioning enables or suspends object versioning. Versions stored while
+// versioning was enabled are kept when it is suspended.
+func (b *S3StorageBucket) SetVersioning(enabled bool) (err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s/versioning", b.S3StorageId, b.ID)
+	args := &struct {
+		Enabled bool `json:"enabled"`
+	}{
+		Enabled: enabled,
+	}
+
+	if err = b.manager.Request("PUT", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] set-bucket-versioning failed: %s", err)
+	}
+
+	return
+}
+
+func (b *S3StorageBucket) GetLifecycle() (rules []*S3BucketLifecycleRule, err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s/lifecycle", b.S3StorageId, b.ID)
+	target := &struct {
+		Rules []*S3BucketLifecycleRule `json:"rules"`
+	}{}
+
+	if err = b.manager.Get(path, Defaults(), target); err != nil {
+		log.Printf("[REQUEST-ERROR] get-bucket-lifecycle failed: %s", err)
+	}
+
+	return target.Rules, err
+}
+
+// SetLifecycle replaces the lifecycle configuration of the bucket, an empty
+// list removes it.
+func (b *S3StorageBucket) SetLifecycle(rules []*S3BucketLifecycleRule) (err error) {
+	path := fmt.Sprintf("v1/s3_storage/%s/bucket/%s/lifecycle", b.S3StorageId, b.ID)
+	if rules == nil {
+		rules = []*S3BucketLifecycleRule{}
+	}
+	args := &struct {
+		Rules []*S3BucketLifecycleRule `json:"rules"`
+	}{
+		Rules: rules,
+	}
+
+	if err = b.manager.Request("PUT", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] set-bucket-lifecycle failed: %s", err)
+	}
+
+	return
+}
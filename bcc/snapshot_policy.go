@@ -0,0 +1,162 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+)
+
+// SnapshotPolicy takes snapshots of the attached vms and disks whenever the
+// cron expression matches and keeps the latest RetentionCount of them.
+type SnapshotPolicy struct {
+	manager        *Manager
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	Vdc            *MetaData   `json:"vdc"`
+	Cron           string      `json:"cron"`
+	Timezone       string      `json:"timezone,omitempty"`
+	RetentionCount int         `json:"retention_count"`
+	Enabled        bool        `json:"enabled"`
+	Vms            []*MetaData `json:"vms"`
+	Disks          []*MetaData `json:"disks"`
+}
+
+func NewSnapshotPolicy(name string, cron string, timezone string, retentionCount int) SnapshotPolicy {
+	p := SnapshotPolicy{Name: name, Cron: cron, Timezone: timezone, RetentionCount: retentionCount, Enabled: true}
+	return p
+}
+
+func (m *Manager) GetSnapshotPolicies(extraArgs ...Arguments) (policies []*SnapshotPolicy, err error) {
+	path := "v1/snapshot_policy"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &policies); err != nil {
+		log.Printf("[REQUEST-ERROR] get-snapshot-policy list failed: %s", err)
+	} else {
+		for i := range policies {
+			policies[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (v *Vdc) GetSnapshotPolicies(extraArgs ...Arguments) (policies []*SnapshotPolicy, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	policies, err = v.manager.GetSnapshotPolicies(args)
+	return
+}
+
+func (m *Manager) GetSnapshotPolicy(id string) (policy *SnapshotPolicy, err error) {
+	path, _ := url.JoinPath("v1/snapshot_policy", id)
+
+	if err = m.Get(path, Defaults(), &policy); err != nil {
+		log.Printf("[REQUEST-ERROR] get-snapshot-policy failed: %s", err)
+	} else {
+		policy.manager = m
+	}
+
+	return
+}
+
+func (v *Vdc) CreateSnapshotPolicy(policy *SnapshotPolicy) (err error) {
+	path := "v1/snapshot_policy"
+	args := &struct {
+		Name           string `json:"name"`
+		Vdc            string `json:"vdc"`
+		Cron           string `json:"cron"`
+		Timezone       string `json:"timezone,omitempty"`
+		RetentionCount int    `json:"retention_count"`
+		Enabled        bool   `json:"enabled"`
+	}{
+		Name:           policy.Name,
+		Vdc:            v.ID,
+		Cron:           policy.Cron,
+		Timezone:       policy.Timezone,
+		RetentionCount: policy.RetentionCount,
+		Enabled:        policy.Enabled,
+	}
+
+	if err = v.manager.Request("POST", path, args, &policy); err != nil {
+		log.Printf("[REQUEST-ERROR] create-snapshot-policy failed: %s", err)
+	} else {
+		policy.manager = v.manager
+	}
+
+	return
+}
+
+func (p *SnapshotPolicy) Update() (err error) {
+	path, _ := url.JoinPath("v1/snapshot_policy", p.ID)
+	args := &struct {
+		Name           string `json:"name"`
+		Cron           string `json:"cron"`
+		Timezone       string `json:"timezone,omitempty"`
+		RetentionCount int    `json:"retention_count"`
+		Enabled        bool   `json:"enabled"`
+	}{
+		Name:           p.Name,
+		Cron:           p.Cron,
+		Timezone:       p.Timezone,
+		RetentionCount: p.RetentionCount,
+		Enabled:        p.Enabled,
+	}
+
+	if err = p.manager.Request("PUT", path, args, p); err != nil {
+		log.Printf("[REQUEST-ERROR] update-snapshot-policy failed: %s", err)
+	}
+
+	return
+}
+
+func (p *SnapshotPolicy) AttachVms(vms ...*Vm) error {
+	return p.changeResources("attach", vms, nil)
+}
+
+func (p *SnapshotPolicy) DetachVms(vms ...*Vm) error {
+	return p.changeResources("detach", vms, nil)
+}
+
+func (p *SnapshotPolicy) AttachDisks(disks ...*Disk) error {
+	return p.changeResources("attach", nil, disks)
+}
+
+func (p *SnapshotPolicy) DetachDisks(disks ...*Disk) error {
+	return p.changeResources("detach", nil, disks)
+}
+
+func (p *SnapshotPolicy) changeResources(action string, vms []*Vm, disks []*Disk) (err error) {
+	path := fmt.Sprintf("v1/snapshot_policy/%s/%s", p.ID, action)
+	args := &struct {
+		Vms   []string `json:"vms"`
+		Disks []string `json:"disks"`
+	}{
+		Vms:   make([]string, 0, len(vms)),
+		Disks: make([]string, 0, len(disks)),
+	}
+
+	for _, vm := range vms {
+		args.Vms = append(args.Vms, vm.ID)
+	}
+	for _, disk := range disks {
+		args.Disks = append(args.Disks, disk.ID)
+	}
+
+	if err = p.manager.Request("POST", path, args, p); err != nil {
+		log.Printf("[REQUEST-ERROR] %s-snapshot-policy failed: %s", action, err)
+	}
+
+	return
+}
+
+func (p *SnapshotPolicy) Delete() (err error) {
+	path, _ := url.JoinPath("v1/snapshot_policy", p.ID)
+	if err = p.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-snapshot-policy failed: %s", err)
+	}
+	return
+}
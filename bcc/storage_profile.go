@@ -13,6 +13,7 @@ type StorageProfile struct {
 	Name        string `json:"name"`
 	MaxDiskSize int    `json:"max_disk_size"`
 	Enabled     bool   `json:"enabled"`
+	Encryption  bool   `json:"encryption"`
 }
 
 func (v *Vdc) GetStorageProfiles(extraArgs ...Arguments) (storageProfiles []*StorageProfile, err error) {
@@ -25,6 +25,10 @@ type Subnet struct {
 	IsDHCP  bool   `json:"enable_dhcp"`
 	Locked  bool   `json:"locked"`
 
+	IpVersion       int    `json:"ip_version,omitempty"`
+	Ipv6RaMode      string `json:"ipv6_ra_mode,omitempty"`
+	Ipv6AddressMode string `json:"ipv6_address_mode,omitempty"`
+
 	DnsServers   []*SubnetDNSServer `json:"dns_servers"`
 	SubnetRoutes []*SubnetRoute     `json:"subnet_routes"`
 
@@ -40,6 +44,16 @@ func NewSubnet(cidr string, gateway string, startIp string, endIp string, isDHCP
 	return s
 }
 
+// NewIpv6Subnet builds an IPv6 subnet. raMode sets the router advertisements
+// and addressMode how ports get their address, both are Ipv6Mode constants.
+func NewIpv6Subnet(cidr string, gateway string, startIp string, endIp string, raMode string, addressMode string) Subnet {
+	s := NewSubnet(cidr, gateway, startIp, endIp, true)
+	s.IpVersion = IpVersion6
+	s.Ipv6RaMode = raMode
+	s.Ipv6AddressMode = addressMode
+	return s
+}
+
 func NewSubnetDNSServer(dnsServer string) SubnetDNSServer {
 	s := SubnetDNSServer{DNSServer: dnsServer}
 	return s
@@ -94,3 +108,28 @@ func (s Subnet) WaitLock() (err error) {
 	path := fmt.Sprintf("v1/network/%s/subnet/%s", s.network.ID, s.ID)
 	return loopWaitLock(s.manager, path)
 }
+
+const (
+	IpStateAllocated = "allocated"
+	IpStateReserved  = "reserved"
+	IpStateFree      = "free"
+)
+
+type SubnetIpAddress struct {
+	IpAddress string     `json:"ip_address"`
+	State     string     `json:"state"`
+	Port      *MetaData  `json:"port,omitempty"`
+	Connected *Connected `json:"connected,omitempty"`
+}
+
+// GetIPUsage lists every address of the subnet pool with its state and, for
+// allocated ones, the port and the vm or router owning it.
+func (s *Subnet) GetIPUsage() (addresses []*SubnetIpAddress, err error) {
+	path := fmt.Sprintf("v1/network/%s/subnet/%s/ip_usage", s.network.ID, s.ID)
+
+	if err = s.manager.GetItems(path, Defaults(), &addresses); err != nil {
+		log.Printf("[REQUEST-ERROR] get-subnet-ip-usage failed: %s", err)
+	}
+
+	return
+}
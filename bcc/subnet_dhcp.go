@@ -0,0 +1,66 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type DhcpReservation struct {
+	subnet     *Subnet
+	ID         string `json:"id"`
+	MacAddress string `json:"mac_address"`
+	IpAddress  string `json:"ip_address"`
+	Hostname   string `json:"hostname,omitempty"`
+}
+
+func NewDhcpReservation(macAddress string, ipAddress string, hostname string) DhcpReservation {
+	r := DhcpReservation{MacAddress: macAddress, IpAddress: ipAddress, Hostname: hostname}
+	return r
+}
+
+func (s *Subnet) GetDhcpReservations(extraArgs ...Arguments) (reservations []*DhcpReservation, err error) {
+	path := fmt.Sprintf("v1/network/%s/subnet/%s/dhcp_reservation", s.network.ID, s.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = s.manager.GetItems(path, args, &reservations); err != nil {
+		log.Printf("[REQUEST-ERROR] get-dhcp-reservation list failed: %s", err)
+	} else {
+		for i := range reservations {
+			reservations[i].subnet = s
+		}
+	}
+
+	return
+}
+
+// CreateDhcpReservation makes the dhcp server of the subnet always hand out
+// the same address to the given mac address.
+func (s *Subnet) CreateDhcpReservation(reservation *DhcpReservation) (err error) {
+	path := fmt.Sprintf("v1/network/%s/subnet/%s/dhcp_reservation", s.network.ID, s.ID)
+	args := &struct {
+		MacAddress string `json:"mac_address"`
+		IpAddress  string `json:"ip_address"`
+		Hostname   string `json:"hostname,omitempty"`
+	}{
+		MacAddress: reservation.MacAddress,
+		IpAddress:  reservation.IpAddress,
+		Hostname:   reservation.Hostname,
+	}
+
+	if err = s.manager.Request("POST", path, args, &reservation); err != nil {
+		log.Printf("[REQUEST-ERROR] create-dhcp-reservation failed: %s", err)
+	} else {
+		reservation.subnet = s
+	}
+
+	return
+}
+
+func (r *DhcpReservation) Delete() (err error) {
+	path := fmt.Sprintf("v1/network/%s/subnet/%s/dhcp_reservation/%s", r.subnet.network.ID, r.subnet.ID, r.ID)
+	if err = r.subnet.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-dhcp-reservation failed: %s", err)
+	}
+	return
+}
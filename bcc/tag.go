@@ -1,5 +1,7 @@
 package bcc
 
+import "log"
+
 type Tag struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -12,3 +14,34 @@ func convertTagsToNames(tags []Tag) []string {
 	}
 	return tagNames
 }
+
+func NewTag(name string) Tag {
+	t := Tag{Name: name}
+	return t
+}
+
+// TagResources adds tag to every resource in resourceIds with a single
+// request, without updating the resources one by one.
+func (m *Manager) TagResources(tag string, resourceIds []string) (err error) {
+	return m.bulkTag("v1/tag/attach", tag, resourceIds)
+}
+
+func (m *Manager) UntagResources(tag string, resourceIds []string) (err error) {
+	return m.bulkTag("v1/tag/detach", tag, resourceIds)
+}
+
+func (m *Manager) bulkTag(path string, tag string, resourceIds []string) (err error) {
+	args := &struct {
+		Tag       string   `json:"tag"`
+		Resources []string `json:"resources"`
+	}{
+		Tag:       tag,
+		Resources: resourceIds,
+	}
+
+	if err = m.Request("POST", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] bulk-tag '%s' failed: %s", tag, err)
+	}
+
+	return
+}
@@ -0,0 +1,105 @@
+package bcc
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TaskHandle tracks platform tasks started by a request which returned
+// without waiting for them.
+type TaskHandle struct {
+	manager *Manager
+	IDs     []string
+	// Timeout limits Wait, it is TaskTimeout by default. Set it to zero to
+	// wait without a limit, until the manager context is done.
+	Timeout time.Duration
+}
+
+func newTaskHandle(manager *Manager, taskIds string) *TaskHandle {
+	h := &TaskHandle{manager: manager, Timeout: TaskTimeout * time.Second}
+	for _, taskId := range strings.Split(taskIds, ",") {
+		if taskId = strings.TrimSpace(taskId); taskId != "" {
+			h.IDs = append(h.IDs, taskId)
+		}
+	}
+	return h
+}
+
+func (h *TaskHandle) Tasks() (tasks []*Task, err error) {
+	for _, taskId := range h.IDs {
+		task, err := h.manager.GetTask(taskId)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return
+}
+
+// Progress returns the average progress of the tasks in percent.
+func (h *TaskHandle) Progress() (int, error) {
+	tasks, err := h.Tasks()
+	if err != nil || len(tasks) == 0 {
+		return 100, err
+	}
+
+	total := 0
+	for _, task := range tasks {
+		if task.finished() {
+			total += 100
+		} else {
+			total += task.Progress
+		}
+	}
+
+	return total / len(tasks), nil
+}
+
+// Wait blocks until all tasks are finished, a task in error status or one
+// which is not finished within Timeout is returned as an error.
+func (h *TaskHandle) Wait() error {
+	start := time.Now()
+	for _, taskId := range h.IDs {
+		if err := h.wait(taskId, start); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *TaskHandle) wait(taskId string, start time.Time) error {
+	m := h.manager.withoutResult()
+	for {
+		task, err := m.GetTask(taskId)
+		if err != nil {
+			return err
+		}
+		if task.Status == TaskStatusError {
+			return errors.Errorf("Task in error status, step: %s", task.Name)
+		}
+		if task.finished() {
+			return nil
+		}
+
+		if h.Timeout > 0 && time.Since(start) > h.Timeout {
+			m.log("[bcc] Waiting task %s took more than %s", taskId, h.Timeout)
+			return errors.Errorf("Task %s timeout, status: %s", taskId, task.Status)
+		}
+
+		if err := m.sleep(RetryTime * time.Millisecond); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *Task) finished() bool {
+	switch t.Status {
+	case "", TaskStatusPending, TaskStatusInProgress:
+		return false
+	}
+	return true
+}
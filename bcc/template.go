@@ -1,17 +1,29 @@
 package bcc
 
 import (
+	"fmt"
 	"log"
 	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	TemplateOsFamilyLinux   = "linux"
+	TemplateOsFamilyWindows = "windows"
+	TemplateOsFamilyOther   = "other"
 )
 
 type Template struct {
-	manager *Manager
-	ID      string  `json:"id"`
-	Name    string  `json:"name"`
-	MinCpu  int     `json:"min_cpu"`
-	MinRam  float64 `json:"min_ram"`
-	MinHdd  int     `json:"min_hdd"`
+	manager     *Manager
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	OsFamily    string  `json:"os_family,omitempty"`
+	OsVersion   string  `json:"os_version,omitempty"`
+	MinCpu      int     `json:"min_cpu"`
+	MinRam      float64 `json:"min_ram"`
+	MinHdd      int     `json:"min_hdd"`
 }
 
 func (m *Manager) GetTemplate(id string) (template *Template, err error) {
@@ -43,3 +55,62 @@ func (v *Vdc) GetTemplates(extraArgs ...Arguments) (templates []*Template, err e
 
 	return
 }
+
+func (h *Hypervisor) GetTemplates(extraArgs ...Arguments) (templates []*Template, err error) {
+	path := "v1/template"
+	args := Arguments{
+		"hypervisor": h.ID,
+	}
+	args.merge(extraArgs)
+
+	if err = h.manager.Get(path, args, &templates); err != nil {
+		log.Printf("[REQUEST-ERROR] get-template list failed: %s", err)
+	} else {
+		for i := range templates {
+			templates[i].manager = h.manager
+		}
+	}
+
+	return
+}
+
+// Validate checks a vm of cpu, ram and a system disk of diskSize meets the
+// minimum requirements of the template, before anything is sent.
+func (t *Template) Validate(cpu int, ram float64, diskSize int) error {
+	if cpu < t.MinCpu {
+		return errors.Errorf("template '%s' requires at least %d cpu, got %d", t.Name, t.MinCpu, cpu)
+	}
+	if ram < t.MinRam {
+		return errors.Errorf("template '%s' requires at least %g GB ram, got %g", t.Name, t.MinRam, ram)
+	}
+	if diskSize < t.MinHdd {
+		return errors.Errorf("template '%s' requires a disk of at least %d GB, got %d", t.Name, t.MinHdd, diskSize)
+	}
+	return nil
+}
+
+func (t *Template) IsWindows() bool {
+	return t.OsFamily == TemplateOsFamilyWindows
+}
+
+func (m *Manager) CreateTemplateFromVm(vmId string, name string) (template *Template, err error) {
+	path := fmt.Sprintf("v1/vm/%s/template", vmId)
+	args := &struct {
+		Name string `json:"name"`
+	}{
+		Name: name,
+	}
+
+	template = &Template{}
+	if err = m.Request("POST", path, args, template); err != nil {
+		log.Printf("[REQUEST-ERROR] create-template from vm with id='%s' failed: %s", vmId, err)
+	} else {
+		template.manager = m
+	}
+
+	return
+}
+
+func (v *Vm) ConvertToTemplate(name string) (*Template, error) {
+	return v.manager.CreateTemplateFromVm(v.ID, name)
+}
@@ -0,0 +1,66 @@
+package bcc
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Time decodes the timestamps returned by the API. Timestamps without a zone
+// are treated as UTC.
+type Time struct {
+	time.Time
+}
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+func ParseTime(value string) (Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return Time{t}, nil
+		}
+	}
+
+	return Time{}, errors.Errorf("unknown time format '%s'", value)
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(b, &value); err != nil {
+		return errors.Wrapf(err, "time must be a string, got %s", string(b))
+	}
+
+	if value == "" {
+		*t = Time{}
+		return nil
+	}
+
+	parsed, err := ParseTime(value)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
@@ -0,0 +1,48 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type TrafficStats struct {
+	RxBytes   int64 `json:"rx_bytes"`
+	TxBytes   int64 `json:"tx_bytes"`
+	RxPackets int64 `json:"rx_packets"`
+	TxPackets int64 `json:"tx_packets"`
+	From      Time  `json:"from"`
+	To        Time  `json:"to"`
+}
+
+// GetStats returns the traffic counters of the network between from and to.
+func (n *Network) GetStats(from time.Time, to time.Time) (stats *TrafficStats, err error) {
+	path := fmt.Sprintf("v1/network/%s/stats", n.ID)
+
+	if stats, err = getTrafficStats(n.manager, path, from, to); err != nil {
+		log.Printf("[REQUEST-ERROR]: get-network-stats with id='%s' failed: %s", n.ID, err)
+	}
+
+	return
+}
+
+func (p *Port) GetStats(from time.Time, to time.Time) (stats *TrafficStats, err error) {
+	path := fmt.Sprintf("v1/port/%s/stats", p.ID)
+
+	if stats, err = getTrafficStats(p.manager, path, from, to); err != nil {
+		log.Printf("[REQUEST-ERROR]: get-port-stats with id='%s' failed: %s", p.ID, err)
+	}
+
+	return
+}
+
+func getTrafficStats(m *Manager, path string, from time.Time, to time.Time) (stats *TrafficStats, err error) {
+	args := Arguments{
+		"from": from.UTC().Format(time.RFC3339),
+		"to":   to.UTC().Format(time.RFC3339),
+	}
+
+	stats = &TrafficStats{}
+	err = m.Get(path, args, stats)
+	return
+}
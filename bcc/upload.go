@@ -0,0 +1,173 @@
+package bcc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+const DefaultUploadChunkSize = 8 * 1024 * 1024 // bytes
+
+type UploadProgress func(uploaded int64, total int64)
+
+type UploadOptions struct {
+	FieldName string
+	FileName  string
+	Fields    map[string]string
+	ChunkSize int64
+	// Offset resumes a chunked upload from the given byte.
+	Offset   int64
+	Progress UploadProgress
+}
+
+// UploadError is returned by UploadChunked when a chunk fails. Offset is the
+// first byte which was not accepted by the server and can be passed back in
+// UploadOptions to resume the upload.
+type UploadError struct {
+	Offset int64
+	err    error
+}
+
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("upload interrupted at byte %d: %s", e.Offset, e.err)
+}
+
+func (e *UploadError) Unwrap() error { return e.err }
+
+type progressReader struct {
+	reader   io.Reader
+	uploaded int64
+	total    int64
+	progress UploadProgress
+}
+
+func (r *progressReader) Read(p []byte) (n int, err error) {
+	n, err = r.reader.Read(p)
+	if n > 0 {
+		r.uploaded += int64(n)
+		if r.progress != nil {
+			r.progress(r.uploaded, r.total)
+		}
+	}
+	return
+}
+
+// Upload streams r as a multipart/form-data request to path.
+func (m *Manager) Upload(path string, r io.Reader, size int64, opts UploadOptions, target interface{}) error {
+	m.log("[bcc] UPLOAD %s", path)
+
+	fieldName := opts.FieldName
+	if fieldName == "" {
+		fieldName = "file"
+	}
+	fileName := opts.FileName
+	if fileName == "" {
+		fileName = fieldName
+	}
+
+	body, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+
+	go func() {
+		for key, value := range opts.Fields {
+			if err := form.WriteField(key, value); err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+
+		part, err := form.CreateFormFile(fieldName, fileName)
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+
+		reader := &progressReader{reader: r, total: size, progress: opts.Progress}
+		if _, err = io.Copy(part, reader); err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+
+		writer.CloseWithError(form.Close())
+	}()
+
+	requestUrl, _ := url.JoinPath(m.BaseURL, path)
+
+	req, err := http.NewRequest("POST", requestUrl, body)
+	if err != nil {
+		body.Close()
+		log.Printf("[REQUEST-ERROR] Invalid POST request %s", requestUrl)
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.Token))
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req = req.WithContext(m.ctx)
+
+	taskIds, err := m.do(req, requestUrl, target, nil)
+	body.Close()
+	if err != nil {
+		return err
+	}
+
+	return m.waitTasks(taskIds)
+}
+
+// UploadChunked sends r to path in Content-Range chunks, so large images can
+// be resumed after a failure instead of being sent again from the start.
+func (m *Manager) UploadChunked(path string, r io.ReaderAt, size int64, opts UploadOptions, target interface{}) error {
+	m.log("[bcc] UPLOAD %s in chunks", path)
+
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultUploadChunkSize
+	}
+
+	requestUrl, _ := url.JoinPath(m.BaseURL, path)
+	chunk := make([]byte, chunkSize)
+
+	for offset := opts.Offset; offset < size; {
+		n, err := r.ReadAt(chunk[:min(chunkSize, size-offset)], offset)
+		if err != nil && err != io.EOF {
+			return &UploadError{Offset: offset, err: err}
+		}
+		if n == 0 {
+			return &UploadError{Offset: offset, err: io.ErrUnexpectedEOF}
+		}
+
+		req, err := http.NewRequest("PUT", requestUrl, bytes.NewReader(chunk[:n]))
+		if err != nil {
+			log.Printf("[REQUEST-ERROR] Invalid PUT request %s", requestUrl)
+			return err
+		}
+
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.Token))
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(n)-1, size))
+		req = req.WithContext(m.ctx)
+
+		var chunkTarget interface{}
+		if offset+int64(n) == size {
+			chunkTarget = target
+		}
+
+		taskIds, err := m.do(req, requestUrl, chunkTarget, nil)
+		if err != nil {
+			return &UploadError{Offset: offset, err: err}
+		}
+		if err = m.waitTasks(taskIds); err != nil {
+			return &UploadError{Offset: offset + int64(n), err: err}
+		}
+
+		offset += int64(n)
+		if opts.Progress != nil {
+			opts.Progress(offset, size)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+const (
+	UserStatusInvited  = "invited"
+	UserStatusActive   = "active"
+	UserStatusDisabled = "disabled"
+)
+
+type User struct {
+	manager  *Manager
+	clientId string
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+}
+
+type UserProjectAccess struct {
+	Project *MetaData `json:"project"`
+	Role    *MetaData `json:"role"`
+}
+
+func NewUser(email string, name string) User {
+	u := User{Email: email, Name: name}
+	return u
+}
+
+func (c *Client) GetUsers(extraArgs ...Arguments) (users []*User, err error) {
+	path := fmt.Sprintf("v1/client/%s/user", c.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = c.manager.GetItems(path, args, &users); err != nil {
+		log.Printf("[REQUEST-ERROR] get-user list failed: %s", err)
+	} else {
+		for i := range users {
+			users[i].manager = c.manager
+			users[i].clientId = c.ID
+		}
+	}
+
+	return
+}
+
+func (c *Client) GetUser(id string) (user *User, err error) {
+	path := fmt.Sprintf("v1/client/%s/user/%s", c.ID, id)
+
+	if err = c.manager.Get(path, Defaults(), &user); err != nil {
+		log.Printf("[REQUEST-ERROR] get-user with id='%s' failed: %s", id, err)
+	} else {
+		user.manager = c.manager
+		user.clientId = c.ID
+	}
+
+	return
+}
+
+// InviteUser sends an invitation to the email of the user. The user stays in
+// UserStatusInvited until the invitation is accepted.
+func (c *Client) InviteUser(user *User) (err error) {
+	path := fmt.Sprintf("v1/client/%s/user", c.ID)
+	args := &struct {
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}{
+		Email: user.Email,
+		Name:  user.Name,
+	}
+
+	if err = c.manager.Request("POST", path, args, &user); err != nil {
+		log.Printf("[REQUEST-ERROR] invite-user failed: %s", err)
+	} else {
+		user.manager = c.manager
+		user.clientId = c.ID
+	}
+
+	return
+}
+
+// Disable blocks the user from logging in without removing the access.
+func (u *User) Disable() error {
+	return u.setStatus(UserStatusDisabled)
+}
+
+func (u *User) Enable() error {
+	return u.setStatus(UserStatusActive)
+}
+
+func (u *User) setStatus(status string) (err error) {
+	path := fmt.Sprintf("v1/client/%s/user/%s", u.clientId, u.ID)
+	args := &struct {
+		Status string `json:"status"`
+	}{
+		Status: status,
+	}
+
+	if err = u.manager.Patch(path, args, u); err != nil {
+		log.Printf("[REQUEST-ERROR] patch-user failed: %s", err)
+	}
+
+	return
+}
+
+func (u *User) GetProjectAccess(extraArgs ...Arguments) (access []*UserProjectAccess, err error) {
+	path := fmt.Sprintf("v1/client/%s/user/%s/project", u.clientId, u.ID)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = u.manager.GetItems(path, args, &access); err != nil {
+		log.Printf("[REQUEST-ERROR] get-user-project list failed: %s", err)
+	}
+
+	return
+}
+
+// Remove takes the user out of the client together with all the access.
+func (u *User) Remove() (err error) {
+	path := fmt.Sprintf("v1/client/%s/user/%s", u.clientId, u.ID)
+	if err = u.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] remove-user failed: %s", err)
+	}
+	return
+}
@@ -1,6 +1,7 @@
 package bcc
 
 import (
+	"context"
 	"log"
 	"net/url"
 )
@@ -25,6 +26,11 @@ type Vdc struct {
 	Tags []Tag `json:"tags"`
 }
 
+type VdcPatch struct {
+	Name *string   `json:"name,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
 func NewVdc(name string, hypervisor *Hypervisor) Vdc {
 	v := Vdc{Name: name, Hypervisor: Hypervisor{ID: hypervisor.ID}}
 	return v
@@ -39,7 +45,7 @@ func (m *Manager) GetVdcs(extraArgs ...Arguments) (vdcs []*Vdc, err error) {
 		log.Printf("[REQUEST-ERROR] get-vdc list failed: %s", err)
 	} else {
 		for i := range vdcs {
-			vdcs[i].manager = m
+			vdcs[i].setManager(m)
 		}
 	}
 
@@ -55,13 +61,31 @@ func (v *Vdc) GetVdcs(extraArgs ...Arguments) (vdcs []*Vdc, err error) {
 	return
 }
 
+func (p *Project) GetVdcs(extraArgs ...Arguments) (vdcs []*Vdc, err error) {
+	args := Arguments{
+		"project": p.ID,
+	}
+	args.merge(extraArgs)
+	vdcs, err = p.manager.GetVdcs(args)
+	return
+}
+
+func (h *Hypervisor) GetVdcs(extraArgs ...Arguments) (vdcs []*Vdc, err error) {
+	args := Arguments{
+		"hypervisor": h.ID,
+	}
+	args.merge(extraArgs)
+	vdcs, err = h.manager.GetVdcs(args)
+	return
+}
+
 func (m *Manager) GetVdc(id string) (vdc *Vdc, err error) {
 	path, _ := url.JoinPath("v1/vdc", id)
 
 	if err = m.Get(path, Defaults(), &vdc); err != nil {
 		log.Printf("[REQUEST-ERROR] get-vdc with id='%s' failed: %s", id, err)
 	} else {
-		vdc.manager = m
+		vdc.setManager(m)
 	}
 
 	return
@@ -83,11 +107,15 @@ func (p *Project) CreateVdc(vdc *Vdc) (err error) {
 
 	if err = p.manager.Request("POST", path, args, &vdc); err != nil {
 		log.Printf("[REQUEST-ERROR] create-vdc failed: %s", err)
-	} else {
-		vdc.manager = p.manager
+		return
 	}
 
-	return
+	vdc.setManager(p.manager)
+	if p.manager.DryRun {
+		return
+	}
+
+	return vdc.WaitLock()
 }
 
 func (v *Vdc) Rename(name string) error {
@@ -105,8 +133,50 @@ func (v *Vdc) Update() (err error) {
 		Tags: convertTagsToNames(v.Tags),
 	}
 
-	if err = v.manager.Request("PUT", path, args, v); err != nil {
+	m := v.manager
+	if err = m.Request("PUT", path, args, v); err != nil {
 		log.Printf("[REQUEST-ERROR] update-vdc failed: %s", err)
+		return
+	}
+
+	v.setManager(m)
+	return v.WaitLock()
+}
+
+func (v *Vdc) Reload() (err error) {
+	path, _ := url.JoinPath("v1/vdc", v.ID)
+	m := v.manager
+
+	if err = m.Get(path, Defaults(), &v); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vdc with id='%s' failed: %s", v.ID, err)
+	} else {
+		v.setManager(m)
+	}
+
+	return
+}
+
+// WithContext returns a copy of the vdc whose requests, and the requests of
+// everything created through it, are bound to ctx.
+func (v *Vdc) WithContext(ctx context.Context) *Vdc {
+	newVdc := *v
+	newVdc.setManager(v.manager.WithContext(ctx))
+	return &newVdc
+}
+
+func (v *Vdc) setManager(m *Manager) {
+	v.manager = m
+	v.Hypervisor.manager = m
+}
+
+func (v *Vdc) Patch(patch VdcPatch) (err error) {
+	path, _ := url.JoinPath("v1/vdc", v.ID)
+
+	m := v.manager
+	if err = m.Patch(path, patch, v); err != nil {
+		log.Printf("[REQUEST-ERROR] patch-vdc failed: %s", err)
+	} else {
+		v.setManager(m)
 	}
 
 	return
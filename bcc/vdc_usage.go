@@ -0,0 +1,55 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type UsagePeriod struct {
+	From time.Time
+	To   time.Time
+}
+
+type StorageUsage struct {
+	StorageProfile *MetaData `json:"storage_profile"`
+	GbHours        float64   `json:"gb_hours"`
+}
+
+// VdcUsage is the metered consumption of a vdc over a period, as used for
+// billing.
+type VdcUsage struct {
+	CpuHours   float64         `json:"cpu_hours"`
+	RamGbHours float64         `json:"ram_gb_hours"`
+	Storage    []*StorageUsage `json:"storage"`
+	RxBytes    int64           `json:"rx_bytes"`
+	TxBytes    int64           `json:"tx_bytes"`
+	From       Time            `json:"from"`
+	To         Time            `json:"to"`
+}
+
+func NewUsagePeriod(from time.Time, to time.Time) UsagePeriod {
+	p := UsagePeriod{From: from, To: to}
+	return p
+}
+
+// MonthUsagePeriod covers the calendar month in UTC.
+func MonthUsagePeriod(year int, month time.Month) UsagePeriod {
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return UsagePeriod{From: from, To: from.AddDate(0, 1, 0)}
+}
+
+func (v *Vdc) GetUsage(period UsagePeriod) (usage *VdcUsage, err error) {
+	path := fmt.Sprintf("v1/vdc/%s/usage", v.ID)
+	args := Arguments{
+		"from": period.From.UTC().Format(time.RFC3339),
+		"to":   period.To.UTC().Format(time.RFC3339),
+	}
+
+	usage = &VdcUsage{}
+	if err = v.manager.Get(path, args, usage); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vdc-usage with id='%s' failed: %s", v.ID, err)
+	}
+
+	return
+}
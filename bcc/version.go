@@ -0,0 +1,5 @@
+package bcc
+
+const Version = "1.0.0"
+
+const defaultUserAgent = "bcc-go/" + Version
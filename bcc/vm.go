@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"slices"
+
+	"github.com/pkg/errors"
 )
 
 type Vm struct {
@@ -27,6 +30,23 @@ type Vm struct {
 	Tags           []Tag            `json:"tags"`
 	Kubernetes     *MetaData        `json:"kubernetes,omitempty"`
 	AffinityGroups []*AffinityGroup `json:"affinity_groups,omitempty"`
+	PublicKeys     []*PubKey        `json:"public_keys,omitempty"`
+	Gpus           []*VmGpu         `json:"gpus,omitempty"`
+	CpuTopology    *CpuTopology     `json:"cpu_topology,omitempty"`
+
+	Customization  *GuestCustomization `json:"-"`
+	CloudInit      *CloudInit          `json:"-"`
+	WindowsLicense *WindowsLicense     `json:"-"`
+}
+
+type VmPatch struct {
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Cpu         *int         `json:"cpu,omitempty"`
+	CpuTopology *CpuTopology `json:"cpu_topology,omitempty"`
+	Ram         *float64     `json:"ram,omitempty"`
+	HotAdd      *bool        `json:"hotadd_feature,omitempty"`
+	Tags        *[]string    `json:"tags,omitempty"`
 }
 
 func NewVm(name string, cpu int, ram float64, template *Template, metadata []*VmMetadata, userData *string, ports []*Port, disks []*Disk, floating *string) Vm {
@@ -46,17 +66,7 @@ func (m *Manager) GetVms(extraArgs ...Arguments) (vms []*Vm, err error) {
 		log.Printf("[REQUEST-ERROR] get-vm list failed: %s", err)
 	} else {
 		for i := range vms {
-			vms[i].manager = m
-			for x := range vms[i].Ports {
-				vms[i].Ports[x].manager = m
-			}
-			for x := range vms[i].Disks {
-				vms[i].Disks[x].manager = m
-			}
-			vms[i].Vdc.manager = m
-			if vms[i].Floating != nil {
-				vms[i].Floating.manager = m
-			}
+			vms[i].setManager(m)
 		}
 	}
 
@@ -78,17 +88,7 @@ func (m *Manager) GetVm(id string) (vm *Vm, err error) {
 	if err = m.Get(path, Defaults(), &vm); err != nil {
 		log.Printf("[REQUEST-ERROR] get-vm failed: %s", err)
 	} else {
-		vm.manager = m
-		for x := range vm.Ports {
-			vm.Ports[x].manager = m
-		}
-		for x := range vm.Disks {
-			vm.Disks[x].manager = m
-		}
-		vm.Vdc.manager = m
-		if vm.Floating != nil {
-			vm.Floating.manager = m
-		}
+		vm.setManager(m)
 	}
 
 	return
@@ -110,9 +110,15 @@ func (v *Vdc) CreateVm(vm *Vm) (err error) {
 		Value string `json:"value"`
 	}
 
-	metaDataList := make([]*metadata, len(vm.Metadata))
-	for idx := range vm.Metadata {
-		metaDataList[idx] = &metadata{Field: vm.Metadata[idx].Field.ID, Value: vm.Metadata[idx].Value}
+	vmMetadata, err := vm.templateMetadata(v.manager)
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] create-vm failed: %s", err)
+		return
+	}
+
+	metaDataList := make([]*metadata, len(vmMetadata))
+	for idx := range vmMetadata {
+		metaDataList[idx] = &metadata{Field: vmMetadata[idx].Field.ID, Value: vmMetadata[idx].Value}
 	}
 
 	type TempDisk struct {
@@ -137,21 +143,46 @@ func (v *Vdc) CreateVm(vm *Vm) (err error) {
 		}
 	}
 
+	var publicKeyList []string
+	for _, publicKey := range vm.PublicKeys {
+		publicKeyList = append(publicKeyList, publicKey.ID)
+	}
+
+	var gpuList []string
+	for _, gpu := range vm.Gpus {
+		if gpu == nil || gpu.Profile == nil {
+			err = errors.Errorf("gpu of vm '%s' has no profile", vm.Name)
+			log.Printf("[REQUEST-ERROR] create-vm failed: %s", err)
+			return
+		}
+		gpuList = append(gpuList, gpu.Profile.ID)
+	}
+
+	cpuTopology, err := vm.cpuTopology()
+	if err != nil {
+		log.Printf("[REQUEST-ERROR] create-vm failed: %s", err)
+		return
+	}
+
 	args := &struct {
-		Name           string      `json:"name"`
-		Cpu            int         `json:"cpu"`
-		Ram            float64     `json:"ram"`
-		Vdc            string      `json:"vdc"`
-		Template       string      `json:"template"`
-		HotAdd         bool        `json:"hotadd_feature"`
-		Ports          []*idList   `json:"ports"`
-		Metadata       []*metadata `json:"metadata"`
-		UserData       *string     `json:"user_data,omitempty"`
-		Disks          []*TempDisk `json:"disks"`
-		Floating       *string     `json:"floating"`
-		Tags           []string    `json:"tags"`
-		Platform       *string     `json:"platform,omitempty"`
-		AffinityGroups []string    `json:"affinity_groups,omitempty"`
+		Name           string       `json:"name"`
+		Cpu            int          `json:"cpu"`
+		Ram            float64      `json:"ram"`
+		Vdc            string       `json:"vdc"`
+		Template       string       `json:"template"`
+		HotAdd         bool         `json:"hotadd_feature"`
+		Ports          []*idList    `json:"ports"`
+		Metadata       []*metadata  `json:"metadata"`
+		UserData       *string      `json:"user_data,omitempty"`
+		MetaData       *string      `json:"meta_data,omitempty"`
+		Disks          []*TempDisk  `json:"disks"`
+		Floating       *string      `json:"floating"`
+		Tags           []string     `json:"tags"`
+		Platform       *string      `json:"platform,omitempty"`
+		AffinityGroups []string     `json:"affinity_groups,omitempty"`
+		PublicKeys     []string     `json:"public_keys,omitempty"`
+		GpuProfiles    []string     `json:"gpu_profiles,omitempty"`
+		CpuTopology    *CpuTopology `json:"cpu_topology,omitempty"`
 	}{
 		Name:           vm.Name,
 		Cpu:            vm.Cpu,
@@ -167,6 +198,9 @@ func (v *Vdc) CreateVm(vm *Vm) (err error) {
 		Tags:           convertTagsToNames(vm.Tags),
 		Platform:       nil,
 		AffinityGroups: affGrList,
+		PublicKeys:     publicKeyList,
+		GpuProfiles:    gpuList,
+		CpuTopology:    cpuTopology,
 	}
 
 	if vm.Floating != nil {
@@ -181,6 +215,14 @@ func (v *Vdc) CreateVm(vm *Vm) (err error) {
 		args.Platform = &vm.Platform.ID
 	}
 
+	if vm.CloudInit != nil {
+		userData, metaData := vm.CloudInit.encode()
+		if userData != nil {
+			args.UserData = userData
+		}
+		args.MetaData = metaData
+	}
+
 	if err = v.manager.Request("POST", path, args, &vm); err != nil {
 		log.Printf("[REQUEST-ERROR] create-vm failed: %s", err)
 	} else {
@@ -206,6 +248,7 @@ func (v *Vm) ConnectPort(port *Port, exsist bool) (err error) {
 		Vm          string   `json:"vm"`
 		Network     string   `json:"network"`
 		IpAddress   *string  `json:"ip_address,omitempty"`
+		Ipv6Address *string  `json:"ipv6_address,omitempty"`
 		FwTemplates []string `json:"fw_templates"`
 		Tags        []string `json:"tags"`
 	}
@@ -218,6 +261,7 @@ func (v *Vm) ConnectPort(port *Port, exsist bool) (err error) {
 		Vm:          v.ID,
 		Network:     port.Network.ID,
 		IpAddress:   port.IpAddress,
+		Ipv6Address: port.Ipv6Address,
 		FwTemplates: fwTemplates,
 		Tags:        convertTagsToNames(port.Tags),
 	}
@@ -265,6 +309,69 @@ func (v *Vm) Reboot() error {
 	return v.updateState("reboot")
 }
 
+// Resize changes cpu and ram of the vm. Running vms are resized in place when
+// hotplug is requested and the vm has the hot add feature, otherwise the vm
+// is powered off for the change and powered on again. When the change fails
+// the vm keeps its old size and is powered on again.
+func (v *Vm) Resize(cpu int, ram float64, hotplug bool) (err error) {
+	var topology *CpuTopology
+	if v.CpuTopology != nil {
+		scaled := *v.CpuTopology
+		scaled.scaleSockets(cpu)
+		if vcpus := scaled.vcpus(); vcpus != cpu {
+			return errors.Errorf("cpu topology of vm '%s' cannot be scaled to %d vcpus", v.Name, cpu)
+		}
+		topology = &scaled
+	}
+
+	hot := hotplug && v.HotAdd && cpu >= v.Cpu && ram >= v.Ram
+	powerCycle := v.Power && !hot
+	poweredOff := false
+
+	if powerCycle {
+		if err = v.PowerOff(); err != nil {
+			return
+		}
+		poweredOff = true
+		defer func() {
+			if !poweredOff {
+				return
+			}
+			if powerErr := v.PowerOn(); powerErr != nil {
+				log.Printf("[REQUEST-ERROR] power-on vm '%s' after failed resize failed: %s", v.ID, powerErr)
+			}
+		}()
+		if err = v.WaitLock(); err != nil {
+			return
+		}
+	}
+
+	oldCpu, oldRam, oldTopology := v.Cpu, v.Ram, v.CpuTopology
+	v.Cpu = cpu
+	v.Ram = ram
+	v.CpuTopology = topology
+	if err = v.Update(); err != nil {
+		log.Printf("[REQUEST-ERROR] resize-vm failed: %s", err)
+		v.Cpu, v.Ram, v.CpuTopology = oldCpu, oldRam, oldTopology
+		return
+	}
+	if err = v.WaitLock(); err != nil {
+		return
+	}
+
+	if powerCycle {
+		if err = v.PowerOn(); err != nil {
+			return
+		}
+		poweredOff = false
+		if err = v.WaitLock(); err != nil {
+			return
+		}
+	}
+
+	return v.Reload()
+}
+
 func (v *Vm) Reload() (err error) {
 	path, _ := url.JoinPath("v1/vm", v.ID)
 	m := v.manager
@@ -272,17 +379,7 @@ func (v *Vm) Reload() (err error) {
 	if err = m.Get(path, Defaults(), &v); err != nil {
 		log.Printf("[REQUEST-ERROR] get-vm failed: %s", err)
 	} else {
-		v.manager = m
-		for x := range v.Ports {
-			v.Ports[x].manager = m
-		}
-		for x := range v.Disks {
-			v.Disks[x].manager = m
-		}
-		v.Vdc.manager = m
-		if v.Floating != nil {
-			v.Floating.manager = m
-		}
+		v.setManager(m)
 	}
 
 	return
@@ -299,14 +396,15 @@ func (v *Vm) Update() (err error) {
 	}
 
 	args := &struct {
-		AffinityGroups []string `json:"affinity_groups"`
-		Name           string   `json:"name"`
-		Description    string   `json:"description"`
-		Cpu            int      `json:"cpu"`
-		Ram            float64  `json:"ram"`
-		HotAdd         bool     `json:"hotadd_feature"`
-		Floating       *string  `json:"floating"`
-		Tags           []string `json:"tags"`
+		AffinityGroups []string     `json:"affinity_groups"`
+		Name           string       `json:"name"`
+		Description    string       `json:"description"`
+		Cpu            int          `json:"cpu"`
+		Ram            float64      `json:"ram"`
+		HotAdd         bool         `json:"hotadd_feature"`
+		Floating       *string      `json:"floating"`
+		Tags           []string     `json:"tags"`
+		CpuTopology    *CpuTopology `json:"cpu_topology,omitempty"`
 	}{
 		AffinityGroups: affGr,
 		Name:           v.Name,
@@ -316,6 +414,7 @@ func (v *Vm) Update() (err error) {
 		HotAdd:         v.HotAdd,
 		Floating:       nil,
 		Tags:           convertTagsToNames(v.Tags),
+		CpuTopology:    v.updateCpuTopology(),
 	}
 
 	if v.Floating != nil {
@@ -333,6 +432,16 @@ func (v *Vm) Update() (err error) {
 	return
 }
 
+func (v *Vm) Patch(patch VmPatch) (err error) {
+	path, _ := url.JoinPath("v1/vm", v.ID)
+
+	if err = v.manager.Patch(path, patch, v); err != nil {
+		log.Printf("[REQUEST-ERROR] patch-vm failed: %s", err)
+	}
+
+	return
+}
+
 func (v *Vm) updateState(state string) (err error) {
 	path := fmt.Sprintf("v1/vm/%s/state", v.ID)
 
@@ -357,7 +466,61 @@ func (v *Vm) Delete() (err error) {
 	return
 }
 
+// SetFirewallTemplates binds the firewall templates to every port of the vm.
+func (v *Vm) SetFirewallTemplates(firewallTemplates []*FirewallTemplate) (err error) {
+	for _, port := range v.Ports {
+		if port.manager == nil {
+			port.manager = v.manager
+		}
+		if err = port.SetFirewallTemplates(firewallTemplates); err != nil {
+			log.Printf("[REQUEST-ERROR] set-firewall-templates of vm with id='%s' failed: %s", v.ID, err)
+			return
+		}
+	}
+
+	return
+}
+
+func (v *Vm) setManager(m *Manager) {
+	v.manager = m
+	for x := range v.Ports {
+		v.Ports[x].manager = m
+	}
+	for x := range v.Disks {
+		v.Disks[x].manager = m
+	}
+	if v.Vdc != nil {
+		v.Vdc.manager = m
+	}
+	if v.Floating != nil {
+		v.Floating.manager = m
+	}
+}
+
 func (v Vm) WaitLock() error {
 	path, _ := url.JoinPath("v1/vm", v.ID)
 	return loopWaitLock(v.manager, path)
 }
+
+// AddTags adds tags to the vm, keeping the ones it already has.
+func (v *Vm) AddTags(tags ...string) error {
+	names := convertTagsToNames(v.Tags)
+	for _, tag := range tags {
+		if !slices.Contains(names, tag) {
+			names = append(names, tag)
+		}
+	}
+
+	return v.Patch(VmPatch{Tags: &names})
+}
+
+func (v *Vm) RemoveTags(tags ...string) error {
+	names := make([]string, 0, len(v.Tags))
+	for _, tag := range v.Tags {
+		if !slices.Contains(tags, tag.Name) {
+			names = append(names, tag.Name)
+		}
+	}
+
+	return v.Patch(VmPatch{Tags: &names})
+}
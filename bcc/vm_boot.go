@@ -0,0 +1,93 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+const (
+	BootDeviceDisk    = "disk"
+	BootDeviceCdrom   = "cdrom"
+	BootDeviceNetwork = "network"
+)
+
+type BootDevice struct {
+	Type  string    `json:"type"`
+	Disk  *MetaData `json:"disk,omitempty"`
+	Order int       `json:"order"`
+}
+
+func NewBootDevice(deviceType string, disk *Disk) BootDevice {
+	b := BootDevice{Type: deviceType}
+	if disk != nil {
+		b.Disk = &MetaData{ID: disk.ID, Name: disk.Name}
+	}
+	return b
+}
+
+func (v *Vm) GetBootOrder() (devices []*BootDevice, err error) {
+	path := fmt.Sprintf("v1/vm/%s/boot_order", v.ID)
+
+	if err = v.manager.Get(path, Defaults(), &devices); err != nil {
+		log.Printf("[REQUEST-ERROR] get-boot-order of vm with id='%s' failed: %s", v.ID, err)
+	}
+
+	return
+}
+
+// SetBootOrder makes the vm try devices in the given order.
+func (v *Vm) SetBootOrder(devices []*BootDevice) (err error) {
+	path := fmt.Sprintf("v1/vm/%s/boot_order", v.ID)
+
+	for i := range devices {
+		devices[i].Order = i + 1
+	}
+
+	args := &struct {
+		Devices []*BootDevice `json:"devices"`
+	}{
+		Devices: devices,
+	}
+
+	if err = v.manager.Request("PUT", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] set-boot-order of vm with id='%s' failed: %s", v.ID, err)
+	}
+
+	return
+}
+
+func (v *Vm) BootFromCdrom() error {
+	return v.bootFirst(BootDeviceCdrom)
+}
+
+func (v *Vm) BootFromNetwork() error {
+	return v.bootFirst(BootDeviceNetwork)
+}
+
+func (v *Vm) BootFromDisk() error {
+	return v.bootFirst(BootDeviceDisk)
+}
+
+func (v *Vm) bootFirst(deviceType string) error {
+	devices, err := v.GetBootOrder()
+	if err != nil {
+		return err
+	}
+
+	first := make([]*BootDevice, 0, len(devices)+1)
+	rest := make([]*BootDevice, 0, len(devices))
+	for _, device := range devices {
+		if device.Type == deviceType {
+			first = append(first, device)
+		} else {
+			rest = append(rest, device)
+		}
+	}
+
+	if len(first) == 0 {
+		device := NewBootDevice(deviceType, nil)
+		first = append(first, &device)
+	}
+
+	return v.SetBootOrder(append(first, rest...))
+}
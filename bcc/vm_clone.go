@@ -0,0 +1,73 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/pkg/errors"
+)
+
+type VmCloneOption func(*vmCloneArgs)
+
+type vmCloneArgs struct {
+	Name           string   `json:"name"`
+	Vdc            string   `json:"vdc"`
+	StorageProfile *string  `json:"storage_profile,omitempty"`
+	Power          *bool    `json:"power,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
+
+func WithCloneStorageProfile(storageProfile *StorageProfile) VmCloneOption {
+	return func(args *vmCloneArgs) {
+		args.StorageProfile = &storageProfile.ID
+	}
+}
+
+func WithClonePower(power bool) VmCloneOption {
+	return func(args *vmCloneArgs) {
+		args.Power = &power
+	}
+}
+
+func WithCloneTags(tags []Tag) VmCloneOption {
+	return func(args *vmCloneArgs) {
+		args.Tags = convertTagsToNames(tags)
+	}
+}
+
+// Clone copies the vm with all its disks into targetVdc and waits until the
+// new vm is ready. A nil targetVdc clones into the vdc of the source vm.
+func (v *Vm) Clone(name string, targetVdc *Vdc, opts ...VmCloneOption) (vm *Vm, err error) {
+	path := fmt.Sprintf("v1/vm/%s/clone", v.ID)
+
+	args := &vmCloneArgs{Name: name}
+	if targetVdc != nil {
+		args.Vdc = targetVdc.ID
+	} else if v.Vdc != nil {
+		args.Vdc = v.Vdc.ID
+	} else {
+		return nil, errors.Errorf("clone of vm '%s' needs a target vdc, the vdc of the vm is unknown", v.ID)
+	}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	vm = &Vm{}
+	if err = v.manager.Request("POST", path, args, vm); err != nil {
+		log.Printf("[REQUEST-ERROR] clone-vm with id='%s' failed: %s", v.ID, err)
+		return
+	}
+
+	vm.setManager(v.manager)
+	if v.manager.DryRun {
+		return
+	}
+
+	if err = vm.WaitLock(); err != nil {
+		log.Printf("[REQUEST-ERROR] wait-lock for cloned vm '%s' failed: %s", vm.ID, err)
+		return
+	}
+
+	err = vm.Reload()
+	return
+}
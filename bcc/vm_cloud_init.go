@@ -0,0 +1,27 @@
+package bcc
+
+import "encoding/base64"
+
+// CloudInit holds plain text cloud-init documents, they are base64 encoded
+// when the vm is created.
+type CloudInit struct {
+	UserData string
+	MetaData string
+}
+
+func NewCloudInit(userData string, metaData string) CloudInit {
+	c := CloudInit{UserData: userData, MetaData: metaData}
+	return c
+}
+
+func (c *CloudInit) encode() (userData *string, metaData *string) {
+	if c.UserData != "" {
+		encoded := base64.StdEncoding.EncodeToString([]byte(c.UserData))
+		userData = &encoded
+	}
+	if c.MetaData != "" {
+		encoded := base64.StdEncoding.EncodeToString([]byte(c.MetaData))
+		metaData = &encoded
+	}
+	return
+}
@@ -0,0 +1,68 @@
+package bcc
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+type VmConsole struct {
+	Url          string `json:"url"`
+	WebsocketUrl string `json:"websocket_url,omitempty"`
+	Token        string `json:"token,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+func (v *Vm) GetConsole() (console *VmConsole, err error) {
+	path := fmt.Sprintf("v1/vm/%s/console", v.ID)
+
+	console = &VmConsole{}
+	if err = v.manager.Get(path, Defaults(), console); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vm-console with id='%s' failed: %s", v.ID, err)
+	}
+
+	return
+}
+
+func (v *Vm) GetConsoleURL() (string, error) {
+	console, err := v.GetConsole()
+	if err != nil {
+		return "", err
+	}
+
+	return console.Url, nil
+}
+
+// Screenshot returns a PNG image of what the vm console currently shows.
+func (v *Vm) Screenshot() ([]byte, error) {
+	path := fmt.Sprintf("v1/vm/%s/console/screenshot", v.ID)
+
+	var buf bytes.Buffer
+	if err := v.manager.Download(path, &buf); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vm-screenshot with id='%s' failed: %s", v.ID, err)
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GetConsoleLog returns the last lines of the serial console output of the
+// vm. A non-positive lines value returns everything the platform keeps.
+func (v *Vm) GetConsoleLog(lines int) (output string, err error) {
+	path := fmt.Sprintf("v1/vm/%s/console/log", v.ID)
+	args := Defaults()
+	if lines > 0 {
+		args["lines"] = strconv.Itoa(lines)
+	}
+
+	consoleLog := &struct {
+		Output string `json:"output"`
+	}{}
+	if err = v.manager.Get(path, args, consoleLog); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vm-console-log with id='%s' failed: %s", v.ID, err)
+		return
+	}
+
+	return consoleLog.Output, nil
+}
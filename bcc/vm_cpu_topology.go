@@ -0,0 +1,63 @@
+package bcc
+
+import "github.com/pkg/errors"
+
+type CpuTopology struct {
+	Sockets        int `json:"sockets"`
+	CoresPerSocket int `json:"cores_per_socket"`
+	ThreadsPerCore int `json:"threads_per_core"`
+}
+
+func NewCpuTopology(sockets int, coresPerSocket int, threadsPerCore int) CpuTopology {
+	t := CpuTopology{Sockets: sockets, CoresPerSocket: coresPerSocket, ThreadsPerCore: threadsPerCore}
+	return t
+}
+
+func (t *CpuTopology) vcpus() int {
+	threads := t.ThreadsPerCore
+	if threads == 0 {
+		threads = 1
+	}
+	return t.Sockets * t.CoresPerSocket * threads
+}
+
+// scaleSockets keeps cores and threads per socket and changes the number of
+// sockets to match vcpus, when that is possible.
+func (t *CpuTopology) scaleSockets(vcpus int) {
+	perSocket := t.vcpus() / max(t.Sockets, 1)
+	if perSocket > 0 && vcpus%perSocket == 0 {
+		t.Sockets = vcpus / perSocket
+	}
+}
+
+// cpuTopology returns the topology to send for a new vm, checking that it adds
+// up to the requested number of vcpus.
+func (v *Vm) cpuTopology() (*CpuTopology, error) {
+	if v.CpuTopology == nil {
+		return nil, nil
+	}
+
+	if vcpus := v.CpuTopology.vcpus(); vcpus != v.Cpu {
+		return nil, errors.Errorf("cpu topology of vm '%s' gives %d vcpus, but cpu is %d", v.Name, vcpus, v.Cpu)
+	}
+
+	return v.CpuTopology, nil
+}
+
+// updateCpuTopology returns the topology to send on update. The topology is
+// loaded from the platform with the vm, so after a plain change of Cpu it no
+// longer adds up: it is then scaled like in Resize, or left out for the
+// platform to choose one when that is not possible.
+func (v *Vm) updateCpuTopology() *CpuTopology {
+	if v.CpuTopology == nil || v.CpuTopology.vcpus() == v.Cpu {
+		return v.CpuTopology
+	}
+
+	scaled := *v.CpuTopology
+	scaled.scaleSockets(v.Cpu)
+	if scaled.vcpus() != v.Cpu {
+		return nil
+	}
+
+	return &scaled
+}
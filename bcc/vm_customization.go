@@ -0,0 +1,113 @@
+package bcc
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// System aliases of the template fields used for guest customization.
+const (
+	TemplateFieldHostname   = "hostname"
+	TemplateFieldDnsServers = "dns"
+	TemplateFieldTimezone   = "timezone"
+	TemplateFieldPassword   = "password"
+	TemplateFieldSysprep    = "sysprep"
+)
+
+type GuestCustomization struct {
+	Hostname      string
+	DnsServers    []string
+	Timezone      string
+	AdminPassword string
+	Sysprep       *bool
+}
+
+func NewGuestCustomization(hostname string, dnsServers []string, timezone string, adminPassword string) GuestCustomization {
+	c := GuestCustomization{Hostname: hostname, DnsServers: dnsServers, Timezone: timezone, AdminPassword: adminPassword}
+	return c
+}
+
+func (c *GuestCustomization) values() map[string]string {
+	values := make(map[string]string)
+	if c.Hostname != "" {
+		values[TemplateFieldHostname] = c.Hostname
+	}
+	if len(c.DnsServers) > 0 {
+		values[TemplateFieldDnsServers] = strings.Join(c.DnsServers, ",")
+	}
+	if c.Timezone != "" {
+		values[TemplateFieldTimezone] = c.Timezone
+	}
+	if c.AdminPassword != "" {
+		values[TemplateFieldPassword] = c.AdminPassword
+	}
+	if c.Sysprep != nil {
+		values[TemplateFieldSysprep] = strconv.FormatBool(*c.Sysprep)
+	}
+	return values
+}
+
+// templateMetadata resolves the typed customization and licensing of the vm
+// into template field values. Metadata set explicitly on the vm takes
+// precedence.
+func (v *Vm) templateMetadata(manager *Manager) ([]*VmMetadata, error) {
+	values := make(map[string]string)
+	if v.Customization != nil {
+		for alias, value := range v.Customization.values() {
+			values[alias] = value
+		}
+	}
+	if v.WindowsLicense != nil {
+		for alias, value := range v.WindowsLicense.values() {
+			values[alias] = value
+		}
+	}
+
+	if len(values) == 0 {
+		return v.Metadata, nil
+	}
+
+	template := Template{manager: manager, ID: v.Template.ID}
+	fields, err := template.GetFields()
+	if err != nil {
+		return nil, errors.Wrapf(err, "get fields of template '%s'", v.Template.ID)
+	}
+
+	// copy, appending to v.Metadata could write into the array of the caller
+	metadata := make([]*VmMetadata, len(v.Metadata), len(v.Metadata)+len(values))
+	copy(metadata, v.Metadata)
+	explicit := make(map[string]bool, len(v.Metadata))
+	for _, item := range v.Metadata {
+		if item == nil {
+			return nil, errors.Errorf("metadata of vm '%s' contains a nil item", v.Name)
+		}
+		explicit[item.Field.ID] = true
+	}
+
+	for _, field := range fields {
+		value, ok := values[field.SystemAlias]
+		if !ok {
+			continue
+		}
+		delete(values, field.SystemAlias)
+		if explicit[field.ID] {
+			continue
+		}
+		item := NewVmMetadata(*field, value)
+		metadata = append(metadata, &item)
+	}
+
+	if len(values) > 0 {
+		unsupported := make([]string, 0, len(values))
+		for alias := range values {
+			unsupported = append(unsupported, alias)
+		}
+		sort.Strings(unsupported)
+		return nil, errors.Errorf("template '%s' does not support customization of: %s", v.Template.ID, strings.Join(unsupported, ", "))
+	}
+
+	return metadata, nil
+}
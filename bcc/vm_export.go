@@ -0,0 +1,50 @@
+package bcc
+
+import (
+	"fmt"
+	"io"
+	"log"
+)
+
+const (
+	VmExportFormatOva = "ova"
+	VmExportFormatOvf = "ovf"
+)
+
+type VmExport struct {
+	ID     string `json:"id"`
+	Format string `json:"format"`
+	Size   int64  `json:"size"`
+}
+
+// Export builds an archive of the vm in the given format on the platform side
+// and writes it to w once it is ready.
+func (v *Vm) Export(format string, w io.Writer) (err error) {
+	path := fmt.Sprintf("v1/vm/%s/export", v.ID)
+
+	args := &struct {
+		Format string `json:"format"`
+	}{
+		Format: format,
+	}
+
+	export := &VmExport{}
+	if err = v.manager.Request("POST", path, args, export); err != nil {
+		log.Printf("[REQUEST-ERROR] export-vm with id='%s' failed: %s", v.ID, err)
+		return
+	}
+	if v.manager.DryRun {
+		return
+	}
+
+	if err = v.WaitLock(); err != nil {
+		return
+	}
+
+	downloadPath := fmt.Sprintf("v1/vm/%s/export/%s/download", v.ID, export.ID)
+	if err = v.manager.Download(downloadPath, w); err != nil {
+		log.Printf("[REQUEST-ERROR] download-vm-export with id='%s' failed: %s", export.ID, err)
+	}
+
+	return
+}
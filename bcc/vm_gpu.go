@@ -0,0 +1,82 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+type GpuProfile struct {
+	manager   *Manager
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Vendor    string `json:"vendor"`
+	Memory    int    `json:"memory"`
+	Available int    `json:"available"`
+}
+
+type VmGpu struct {
+	ID      string      `json:"id"`
+	Profile *GpuProfile `json:"profile"`
+}
+
+func NewVmGpu(profile *GpuProfile) VmGpu {
+	g := VmGpu{Profile: profile}
+	return g
+}
+
+func (m *Manager) GetGpuProfiles(hypervisorId string, extraArgs ...Arguments) (profiles []*GpuProfile, err error) {
+	path := fmt.Sprintf("v1/hypervisor/%s/gpu_profile", hypervisorId)
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &profiles); err != nil {
+		log.Printf("[REQUEST-ERROR] get-gpu-profile list failed: %s", err)
+	} else {
+		for i := range profiles {
+			profiles[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (h *Hypervisor) GetGpuProfiles(extraArgs ...Arguments) (profiles []*GpuProfile, err error) {
+	profiles, err = h.manager.GetGpuProfiles(h.ID, extraArgs...)
+	return
+}
+
+func (v *Vm) AttachGpu(profile *GpuProfile) (gpu *VmGpu, err error) {
+	path := fmt.Sprintf("v1/vm/%s/gpu", v.ID)
+	args := &struct {
+		Profile string `json:"profile"`
+	}{
+		Profile: profile.ID,
+	}
+
+	gpu = &VmGpu{}
+	if err = v.manager.Request("POST", path, args, gpu); err != nil {
+		log.Printf("[REQUEST-ERROR] attach-gpu to vm with id='%s' failed: %s", v.ID, err)
+		return
+	}
+
+	v.Gpus = append(v.Gpus, gpu)
+	return
+}
+
+func (v *Vm) DetachGpu(gpu *VmGpu) (err error) {
+	path := fmt.Sprintf("v1/vm/%s/gpu/%s", v.ID, gpu.ID)
+
+	if err = v.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] detach-gpu from vm with id='%s' failed: %s", v.ID, err)
+		return
+	}
+
+	for i, vmGpu := range v.Gpus {
+		if vmGpu.ID == gpu.ID {
+			v.Gpus = append(v.Gpus[:i], v.Gpus[i+1:]...)
+			break
+		}
+	}
+
+	return
+}
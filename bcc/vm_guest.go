@@ -0,0 +1,68 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+)
+
+type GuestCommandResult struct {
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	ExitCode int    `json:"exit_code"`
+}
+
+// RunGuestCommand executes cmd inside the vm through the guest agent and
+// waits up to timeout for it to finish.
+func (v *Vm) RunGuestCommand(cmd string, args []string, timeout time.Duration) (result *GuestCommandResult, err error) {
+	path := fmt.Sprintf("v1/vm/%s/guest_exec", v.ID)
+	if args == nil {
+		args = []string{}
+	}
+	request := &struct {
+		Command string   `json:"command"`
+		Args    []string `json:"args"`
+		Timeout int      `json:"timeout"`
+	}{
+		Command: cmd,
+		Args:    args,
+		// the platform counts in whole seconds, round up so a short timeout
+		// does not turn into zero
+		Timeout: int(math.Ceil(timeout.Seconds())),
+	}
+
+	result = &GuestCommandResult{}
+	if err = v.manager.Request("POST", path, request, result); err != nil {
+		log.Printf("[REQUEST-ERROR] guest-exec on vm with id='%s' failed: %s", v.ID, err)
+	}
+
+	return
+}
+
+type GuestCredential struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// ResetPassword asks the guest agent to set a new generated password for
+// username and returns it.
+func (v *Vm) ResetPassword(username string) (credential *GuestCredential, err error) {
+	path := fmt.Sprintf("v1/vm/%s/reset_password", v.ID)
+	request := &struct {
+		Username string `json:"username"`
+	}{
+		Username: username,
+	}
+
+	credential = &GuestCredential{}
+	if err = v.manager.Request("POST", path, request, credential); err != nil {
+		log.Printf("[REQUEST-ERROR] reset-password on vm with id='%s' failed: %s", v.ID, err)
+	}
+
+	if credential.Username == "" {
+		credential.Username = username
+	}
+
+	return
+}
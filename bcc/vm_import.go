@@ -0,0 +1,74 @@
+package bcc
+
+import (
+	"io"
+	"log"
+)
+
+const (
+	VmImportFormatOva   = "ova"
+	VmImportFormatQcow2 = "qcow2"
+)
+
+// ImportVm uploads an OVA or qcow2 image of the given size and creates a vm
+// from it in the vdc.
+func (v *Vdc) ImportVm(name string, format string, r io.Reader, size int64, progress UploadProgress) (vm *Vm, err error) {
+	path := "v1/vm/import"
+
+	opts := UploadOptions{
+		FieldName: "image",
+		FileName:  name + "." + format,
+		Fields: map[string]string{
+			"name":   name,
+			"vdc":    v.ID,
+			"format": format,
+		},
+		Progress: progress,
+	}
+
+	vm = &Vm{}
+	if err = v.manager.Upload(path, r, size, opts, vm); err != nil {
+		log.Printf("[REQUEST-ERROR] import-vm failed: %s", err)
+		return
+	}
+
+	return v.finishImport(vm)
+}
+
+// ImportVmFromImage creates a vm from an image which was already uploaded to
+// the platform.
+func (v *Vdc) ImportVmFromImage(name string, imageId string) (vm *Vm, err error) {
+	path := "v1/vm/import"
+
+	args := &struct {
+		Name  string `json:"name"`
+		Vdc   string `json:"vdc"`
+		Image string `json:"image"`
+	}{
+		Name:  name,
+		Vdc:   v.ID,
+		Image: imageId,
+	}
+
+	vm = &Vm{}
+	if err = v.manager.Request("POST", path, args, vm); err != nil {
+		log.Printf("[REQUEST-ERROR] import-vm from image '%s' failed: %s", imageId, err)
+		return
+	}
+
+	return v.finishImport(vm)
+}
+
+func (v *Vdc) finishImport(vm *Vm) (*Vm, error) {
+	vm.setManager(v.manager)
+	if v.manager.DryRun {
+		return vm, nil
+	}
+
+	if err := vm.WaitLock(); err != nil {
+		log.Printf("[REQUEST-ERROR] wait-lock for imported vm '%s' failed: %s", vm.ID, err)
+		return vm, err
+	}
+
+	return vm, vm.Reload()
+}
@@ -0,0 +1,47 @@
+package bcc
+
+import (
+	"fmt"
+	"log"
+)
+
+// MoveToVdc migrates the vm into target. networkMapping maps ids of networks
+// the vm ports are connected to onto ids of networks in the target vdc; ports
+// of unmapped networks are disconnected by the platform.
+func (v *Vm) MoveToVdc(target *Vdc, networkMapping map[string]string) (err error) {
+	path := fmt.Sprintf("v1/vm/%s/migrate", v.ID)
+
+	type portMapping struct {
+		Port    string `json:"port"`
+		Network string `json:"network"`
+	}
+
+	ports := make([]*portMapping, 0, len(v.Ports))
+	for _, port := range v.Ports {
+		if port.Network == nil {
+			continue
+		}
+		if network, ok := networkMapping[port.Network.ID]; ok {
+			ports = append(ports, &portMapping{Port: port.ID, Network: network})
+		}
+	}
+
+	args := &struct {
+		Vdc   string         `json:"vdc"`
+		Ports []*portMapping `json:"ports"`
+	}{
+		Vdc:   target.ID,
+		Ports: ports,
+	}
+
+	if err = v.manager.Request("POST", path, args, nil); err != nil {
+		log.Printf("[REQUEST-ERROR] move-vm with id='%s' to vdc '%s' failed: %s", v.ID, target.ID, err)
+		return
+	}
+
+	if err = v.WaitLock(); err != nil {
+		return
+	}
+
+	return v.Reload()
+}
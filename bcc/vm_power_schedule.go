@@ -0,0 +1,113 @@
+package bcc
+
+import (
+	"log"
+	"net/url"
+)
+
+const (
+	PowerScheduleActionPowerOn  = "power_on"
+	PowerScheduleActionPowerOff = "power_off"
+	PowerScheduleActionReboot   = "reboot"
+)
+
+type VmPowerSchedule struct {
+	manager  *Manager
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Vm       *MetaData `json:"vm"`
+	Action   string    `json:"action"`
+	Cron     string    `json:"cron"`
+	Timezone string    `json:"timezone,omitempty"`
+	Enabled  bool      `json:"enabled"`
+}
+
+// NewVmPowerSchedule describes a power action which runs whenever the cron
+// expression matches, e.g. "0 22 * * 1-5" for weekday evenings.
+func NewVmPowerSchedule(name string, action string, cron string, timezone string) VmPowerSchedule {
+	s := VmPowerSchedule{Name: name, Action: action, Cron: cron, Timezone: timezone, Enabled: true}
+	return s
+}
+
+func (m *Manager) GetVmPowerSchedules(extraArgs ...Arguments) (schedules []*VmPowerSchedule, err error) {
+	path := "v1/power_schedule"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &schedules); err != nil {
+		log.Printf("[REQUEST-ERROR] get-power-schedule list failed: %s", err)
+	} else {
+		for i := range schedules {
+			schedules[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (v *Vm) GetPowerSchedules(extraArgs ...Arguments) (schedules []*VmPowerSchedule, err error) {
+	args := Arguments{
+		"vm": v.ID,
+	}
+	args.merge(extraArgs)
+	schedules, err = v.manager.GetVmPowerSchedules(args)
+	return
+}
+
+func (v *Vm) CreatePowerSchedule(schedule *VmPowerSchedule) (err error) {
+	path := "v1/power_schedule"
+	args := &struct {
+		Name     string `json:"name"`
+		Vm       string `json:"vm"`
+		Action   string `json:"action"`
+		Cron     string `json:"cron"`
+		Timezone string `json:"timezone,omitempty"`
+		Enabled  bool   `json:"enabled"`
+	}{
+		Name:     schedule.Name,
+		Vm:       v.ID,
+		Action:   schedule.Action,
+		Cron:     schedule.Cron,
+		Timezone: schedule.Timezone,
+		Enabled:  schedule.Enabled,
+	}
+
+	if err = v.manager.Request("POST", path, args, &schedule); err != nil {
+		log.Printf("[REQUEST-ERROR] create-power-schedule failed: %s", err)
+	} else {
+		schedule.manager = v.manager
+	}
+
+	return
+}
+
+func (s *VmPowerSchedule) Update() (err error) {
+	path, _ := url.JoinPath("v1/power_schedule", s.ID)
+	args := &struct {
+		Name     string `json:"name"`
+		Action   string `json:"action"`
+		Cron     string `json:"cron"`
+		Timezone string `json:"timezone,omitempty"`
+		Enabled  bool   `json:"enabled"`
+	}{
+		Name:     s.Name,
+		Action:   s.Action,
+		Cron:     s.Cron,
+		Timezone: s.Timezone,
+		Enabled:  s.Enabled,
+	}
+
+	if err = s.manager.Request("PUT", path, args, s); err != nil {
+		log.Printf("[REQUEST-ERROR] update-power-schedule failed: %s", err)
+	}
+
+	return
+}
+
+func (s *VmPowerSchedule) Delete() (err error) {
+	path, _ := url.JoinPath("v1/power_schedule", s.ID)
+	if err = s.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-power-schedule failed: %s", err)
+	}
+	return
+}
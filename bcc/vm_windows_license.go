@@ -0,0 +1,52 @@
+package bcc
+
+import "strconv"
+
+// System aliases of the template fields used for windows licensing.
+const (
+	TemplateFieldLicenseMode = "license_mode"
+	TemplateFieldProductKey  = "product_key"
+	TemplateFieldKmsServer   = "kms_server"
+	TemplateFieldKmsPort     = "kms_port"
+)
+
+const (
+	WindowsLicenseModeProvider = "provider"
+	WindowsLicenseModeKms      = "kms"
+	WindowsLicenseModeMak      = "mak"
+	WindowsLicenseModeByol     = "byol"
+)
+
+type WindowsLicense struct {
+	Mode       string
+	ProductKey string
+	KmsServer  string
+	KmsPort    int
+}
+
+func NewWindowsLicense(mode string) WindowsLicense {
+	l := WindowsLicense{Mode: mode}
+	return l
+}
+
+func NewKmsWindowsLicense(kmsServer string, kmsPort int) WindowsLicense {
+	l := WindowsLicense{Mode: WindowsLicenseModeKms, KmsServer: kmsServer, KmsPort: kmsPort}
+	return l
+}
+
+func (l *WindowsLicense) values() map[string]string {
+	values := make(map[string]string)
+	if l.Mode != "" {
+		values[TemplateFieldLicenseMode] = l.Mode
+	}
+	if l.ProductKey != "" {
+		values[TemplateFieldProductKey] = l.ProductKey
+	}
+	if l.KmsServer != "" {
+		values[TemplateFieldKmsServer] = l.KmsServer
+	}
+	if l.KmsPort != 0 {
+		values[TemplateFieldKmsPort] = strconv.Itoa(l.KmsPort)
+	}
+	return values
+}
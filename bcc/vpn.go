@@ -0,0 +1,185 @@
+package bcc
+
+import (
+	"log"
+	"net/url"
+)
+
+const (
+	VpnStatusActive = "ACTIVE"
+	VpnStatusDown   = "DOWN"
+	VpnStatusError  = "ERROR"
+)
+
+type VpnIkePolicy struct {
+	Version    string `json:"ike_version"`
+	Encryption string `json:"encryption_algorithm"`
+	Integrity  string `json:"auth_algorithm"`
+	DhGroup    string `json:"pfs"`
+	Lifetime   int    `json:"lifetime"`
+}
+
+type VpnIpsecPolicy struct {
+	Protocol   string `json:"transform_protocol"`
+	Encryption string `json:"encryption_algorithm"`
+	Integrity  string `json:"auth_algorithm"`
+	Pfs        string `json:"pfs"`
+	Lifetime   int    `json:"lifetime"`
+}
+
+type Vpn struct {
+	manager      *Manager
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Router       *MetaData       `json:"router"`
+	Vdc          *MetaData       `json:"vdc,omitempty"`
+	PeerAddress  string          `json:"peer_address"`
+	Psk          string          `json:"psk,omitempty"`
+	LocalSubnets []string        `json:"local_subnets"`
+	PeerSubnets  []string        `json:"peer_subnets"`
+	IkePolicy    *VpnIkePolicy   `json:"ike_policy"`
+	IpsecPolicy  *VpnIpsecPolicy `json:"ipsec_policy"`
+	Status       string          `json:"status"`
+	Locked       bool            `json:"locked"`
+	Tags         []Tag           `json:"tags"`
+}
+
+func NewVpn(name string, peerAddress string, psk string, localSubnets []string, peerSubnets []string, ikePolicy *VpnIkePolicy, ipsecPolicy *VpnIpsecPolicy) Vpn {
+	v := Vpn{
+		Name:         name,
+		PeerAddress:  peerAddress,
+		Psk:          psk,
+		LocalSubnets: localSubnets,
+		PeerSubnets:  peerSubnets,
+		IkePolicy:    ikePolicy,
+		IpsecPolicy:  ipsecPolicy,
+	}
+	return v
+}
+
+func (m *Manager) GetVpns(extraArgs ...Arguments) (vpns []*Vpn, err error) {
+	path := "v1/vpn"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &vpns); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vpn list failed: %s", err)
+	} else {
+		for i := range vpns {
+			vpns[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (v *Vdc) GetVpns(extraArgs ...Arguments) (vpns []*Vpn, err error) {
+	args := Arguments{
+		"vdc": v.ID,
+	}
+	args.merge(extraArgs)
+	vpns, err = v.manager.GetVpns(args)
+	return
+}
+
+func (m *Manager) GetVpn(id string) (vpn *Vpn, err error) {
+	path, _ := url.JoinPath("v1/vpn", id)
+
+	if err = m.Get(path, Defaults(), &vpn); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vpn with id='%s' failed: %s", id, err)
+	} else {
+		vpn.manager = m
+	}
+
+	return
+}
+
+// CreateVpn sets up a site-to-site IPsec tunnel terminated on the router.
+func (r *Router) CreateVpn(vpn *Vpn) (err error) {
+	path := "v1/vpn"
+	args := &struct {
+		Name         string          `json:"name"`
+		Router       string          `json:"router"`
+		PeerAddress  string          `json:"peer_address"`
+		Psk          string          `json:"psk"`
+		LocalSubnets []string        `json:"local_subnets"`
+		PeerSubnets  []string        `json:"peer_subnets"`
+		IkePolicy    *VpnIkePolicy   `json:"ike_policy,omitempty"`
+		IpsecPolicy  *VpnIpsecPolicy `json:"ipsec_policy,omitempty"`
+		Tags         []string        `json:"tags"`
+	}{
+		Name:         vpn.Name,
+		Router:       r.ID,
+		PeerAddress:  vpn.PeerAddress,
+		Psk:          vpn.Psk,
+		LocalSubnets: vpn.LocalSubnets,
+		PeerSubnets:  vpn.PeerSubnets,
+		IkePolicy:    vpn.IkePolicy,
+		IpsecPolicy:  vpn.IpsecPolicy,
+		Tags:         convertTagsToNames(vpn.Tags),
+	}
+
+	if err = r.manager.Request("POST", path, args, &vpn); err != nil {
+		log.Printf("[REQUEST-ERROR] create-vpn failed: %s", err)
+	} else {
+		vpn.manager = r.manager
+	}
+
+	return
+}
+
+func (v *Vpn) Update() (err error) {
+	path, _ := url.JoinPath("v1/vpn", v.ID)
+	args := &struct {
+		Name         string          `json:"name"`
+		PeerAddress  string          `json:"peer_address"`
+		Psk          string          `json:"psk,omitempty"`
+		LocalSubnets []string        `json:"local_subnets"`
+		PeerSubnets  []string        `json:"peer_subnets"`
+		IkePolicy    *VpnIkePolicy   `json:"ike_policy,omitempty"`
+		IpsecPolicy  *VpnIpsecPolicy `json:"ipsec_policy,omitempty"`
+		Tags         []string        `json:"tags"`
+	}{
+		Name:         v.Name,
+		PeerAddress:  v.PeerAddress,
+		Psk:          v.Psk,
+		LocalSubnets: v.LocalSubnets,
+		PeerSubnets:  v.PeerSubnets,
+		IkePolicy:    v.IkePolicy,
+		IpsecPolicy:  v.IpsecPolicy,
+		Tags:         convertTagsToNames(v.Tags),
+	}
+
+	if err = v.manager.Request("PUT", path, args, v); err != nil {
+		log.Printf("[REQUEST-ERROR] update-vpn failed: %s", err)
+	}
+
+	return
+}
+
+// GetStatus refreshes the vpn and returns the current tunnel status.
+func (v *Vpn) GetStatus() (status string, err error) {
+	path, _ := url.JoinPath("v1/vpn", v.ID)
+	m := v.manager
+
+	if err = m.Get(path, Defaults(), &v); err != nil {
+		log.Printf("[REQUEST-ERROR] get-vpn with id='%s' failed: %s", v.ID, err)
+		return
+	}
+
+	v.manager = m
+	return v.Status, nil
+}
+
+func (v *Vpn) Delete() (err error) {
+	path, _ := url.JoinPath("v1/vpn", v.ID)
+	if err = v.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-vpn failed: %s", err)
+	}
+	return
+}
+
+func (v Vpn) WaitLock() error {
+	path, _ := url.JoinPath("v1/vpn", v.ID)
+	return loopWaitLock(v.manager, path)
+}
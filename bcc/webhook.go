@@ -0,0 +1,139 @@
+package bcc
+
+import (
+	"log"
+	"net/url"
+)
+
+const (
+	WebhookEventTaskDone        = "task_done"
+	WebhookEventQuotaAlert      = "quota_alert"
+	WebhookEventResourceDeleted = "resource_deleted"
+)
+
+type Webhook struct {
+	manager *Manager
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Url     string   `json:"url"`
+	Events  []string `json:"events"`
+	Secret  string   `json:"secret,omitempty"`
+	Enabled bool     `json:"enabled"`
+	Project *Project `json:"project"`
+	Locked  bool     `json:"locked"`
+}
+
+func NewWebhook(name string, webhookUrl string, events []string, secret string) Webhook {
+	w := Webhook{Name: name, Url: webhookUrl, Events: events, Secret: secret, Enabled: true}
+	return w
+}
+
+func (m *Manager) GetWebhooks(extraArgs ...Arguments) (webhooks []*Webhook, err error) {
+	path := "v1/webhook"
+	args := Defaults()
+	args.merge(extraArgs)
+
+	if err = m.GetItems(path, args, &webhooks); err != nil {
+		log.Printf("[REQUEST-ERROR] get-webhook list failed: %s", err)
+	} else {
+		for i := range webhooks {
+			webhooks[i].manager = m
+		}
+	}
+
+	return
+}
+
+func (p *Project) GetWebhooks(extraArgs ...Arguments) (webhooks []*Webhook, err error) {
+	args := Arguments{
+		"project": p.ID,
+	}
+	args.merge(extraArgs)
+	webhooks, err = p.manager.GetWebhooks(args)
+	return
+}
+
+func (m *Manager) GetWebhook(id string) (webhook *Webhook, err error) {
+	path, _ := url.JoinPath("v1/webhook", id)
+
+	if err = m.Get(path, Defaults(), &webhook); err != nil {
+		log.Printf("[REQUEST-ERROR] get-webhook with id='%s' failed: %s", id, err)
+	} else {
+		webhook.manager = m
+	}
+
+	return
+}
+
+func (p *Project) CreateWebhook(webhook *Webhook) (err error) {
+	path := "v1/webhook"
+	args := &struct {
+		Name    string   `json:"name"`
+		Url     string   `json:"url"`
+		Events  []string `json:"events"`
+		Secret  string   `json:"secret,omitempty"`
+		Enabled bool     `json:"enabled"`
+		Project string   `json:"project"`
+	}{
+		Name:    webhook.Name,
+		Url:     webhook.Url,
+		Events:  webhook.Events,
+		Secret:  webhook.Secret,
+		Enabled: webhook.Enabled,
+		Project: p.ID,
+	}
+
+	if err = p.manager.Request("POST", path, args, &webhook); err != nil {
+		log.Printf("[REQUEST-ERROR] create-webhook failed: %s", err)
+	} else {
+		webhook.manager = p.manager
+	}
+
+	return
+}
+
+func (w *Webhook) Update() (err error) {
+	path, _ := url.JoinPath("v1/webhook", w.ID)
+	args := &struct {
+		Name    string   `json:"name"`
+		Url     string   `json:"url"`
+		Events  []string `json:"events"`
+		Secret  string   `json:"secret,omitempty"`
+		Enabled bool     `json:"enabled"`
+	}{
+		Name:    w.Name,
+		Url:     w.Url,
+		Events:  w.Events,
+		Secret:  w.Secret,
+		Enabled: w.Enabled,
+	}
+
+	if err = w.manager.Request("PUT", path, args, w); err != nil {
+		log.Printf("[REQUEST-ERROR] update-webhook failed: %s", err)
+	}
+
+	return
+}
+
+func (w *Webhook) Enable() error {
+	w.Enabled = true
+	return w.Update()
+}
+
+func (w *Webhook) Disable() error {
+	w.Enabled = false
+	return w.Update()
+}
+
+func (w *Webhook) Delete() (err error) {
+	path, _ := url.JoinPath("v1/webhook", w.ID)
+	if err = w.manager.Delete(path, Defaults(), nil); err != nil {
+		log.Printf("[REQUEST-ERROR] delete-webhook failed: %s", err)
+	}
+	return
+}
+
+func (w Webhook) WaitLock() error {
+	path, _ := url.JoinPath("v1/webhook", w.ID)
+	return loopWaitLock(w.manager, path)
+}